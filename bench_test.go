@@ -0,0 +1,223 @@
+package sqlog_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"log/slog"
+	"testing"
+
+	"github.com/mdigger/sqlog"
+	"github.com/mdigger/sqlog/sqlogtest/fakedriver"
+)
+
+func init() {
+	sql.Register("fakebench", fakedriver.New(&fakedriver.Options{
+		Interfaces: fakedriver.All,
+		Rows: func(string, []driver.NamedValue) ([]string, [][]driver.Value) {
+			return []string{"id"}, [][]driver.Value{{int64(1)}}
+		},
+	}))
+}
+
+// disabledHandler is the handler with all the levels disabled, so the
+// benchmarks measure the overhead of the wrappers only.
+type disabledHandler struct{}
+
+func (disabledHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (disabledHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h disabledHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h disabledHandler) WithGroup(string) slog.Handler           { return h }
+
+func openRaw(tb testing.TB) *sql.DB {
+	tb.Helper()
+
+	db, err := sql.Open("fakebench", "")
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	tb.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func openSqlog(tb testing.TB) *sql.DB {
+	tb.Helper()
+
+	db, err := sqlog.Open("fakebench", "", sqlog.WithHandler(disabledHandler{}))
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	tb.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func benchExec(b *testing.B, db *sql.DB) {
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := db.ExecContext(ctx, "UPDATE users SET name = ? WHERE id = ?", "alice", 1); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchQuery(b *testing.B, db *sql.DB) {
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		rows, err := db.QueryContext(ctx, "SELECT id FROM users WHERE name = ?", "alice")
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		for rows.Next() {
+		}
+
+		if err := rows.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchStmtExec(b *testing.B, db *sql.DB) {
+	ctx := context.Background()
+
+	stmt, err := db.PrepareContext(ctx, "UPDATE users SET name = ? WHERE id = ?")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer stmt.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := stmt.ExecContext(ctx, "alice", 1); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchBeginCommit(b *testing.B, db *sql.DB) {
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		if err := tx.Commit(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkExecRaw(b *testing.B)          { benchExec(b, openRaw(b)) }
+func BenchmarkExecSqlog(b *testing.B)        { benchExec(b, openSqlog(b)) }
+func BenchmarkQueryRaw(b *testing.B)         { benchQuery(b, openRaw(b)) }
+func BenchmarkQuerySqlog(b *testing.B)       { benchQuery(b, openSqlog(b)) }
+func BenchmarkStmtExecRaw(b *testing.B)      { benchStmtExec(b, openRaw(b)) }
+func BenchmarkStmtExecSqlog(b *testing.B)    { benchStmtExec(b, openSqlog(b)) }
+func BenchmarkBeginCommitRaw(b *testing.B)   { benchBeginCommit(b, openRaw(b)) }
+func BenchmarkBeginCommitSqlog(b *testing.B) { benchBeginCommit(b, openSqlog(b)) }
+
+// TestAllocs checks that the wrappers with the disabled logging allocate
+// no more than the wrapped transaction itself.
+func TestAllocs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipped in short mode")
+	}
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		extra float64 // allocations of the wrappers
+		run   func(t *testing.T, db *sql.DB) func()
+	}{
+		{"exec", 0, func(t *testing.T, db *sql.DB) func() {
+			return func() {
+				if _, err := db.ExecContext(ctx, "UPDATE users SET name = ? WHERE id = ?", "alice", 1); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}},
+		{"query", 0, func(t *testing.T, db *sql.DB) func() {
+			return func() {
+				rows, err := db.QueryContext(ctx, "SELECT id FROM users WHERE name = ?", "alice")
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				for rows.Next() {
+				}
+
+				rows.Close()
+			}
+		}},
+		{"stmtExec", 0, func(t *testing.T, db *sql.DB) func() {
+			stmt, err := db.PrepareContext(ctx, "UPDATE users SET name = ? WHERE id = ?")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			t.Cleanup(func() { stmt.Close() })
+
+			return func() {
+				if _, err := stmt.ExecContext(ctx, "alice", 1); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}},
+		{"beginCommit", 1, func(t *testing.T, db *sql.DB) func() {
+			return func() {
+				tx, err := db.BeginTx(ctx, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if err := tx.Commit(); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := minAllocs(tt.run(t, openRaw(t)))
+			wrapped := minAllocs(tt.run(t, openSqlog(t)))
+
+			if wrapped > raw+tt.extra {
+				t.Errorf("%v allocations per run, want at most %v: %v of the driver and %v of the wrappers",
+					wrapped, raw+tt.extra, raw, tt.extra)
+			}
+		})
+	}
+}
+
+// minAllocs returns the least average number of allocations of f in a few
+// measurements, ignoring the allocations of the database/sql goroutines
+// that sometimes fall into a measurement.
+func minAllocs(f func()) float64 {
+	allocs := testing.AllocsPerRun(1000, f)
+	for i := 0; i < 2; i++ {
+		allocs = min(allocs, testing.AllocsPerRun(1000, f))
+	}
+
+	return allocs
+}
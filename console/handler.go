@@ -0,0 +1,356 @@
+// Package console provides the slog.Handler rendering SQL logs in
+// a human-readable form for local development: highlighted and reindented
+// queries, arguments aligned under them, color-coded durations and
+// the statements of transactions grouped by txID.
+package console
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Options are options for the Handler.
+type Options struct {
+	Level        slog.Leveler  // minimum level, slog.LevelInfo by default
+	NoColor      bool          // disable ANSI colors
+	TimeFormat   string        // time format, "15:04:05.000" by default
+	SlowDuration time.Duration // yellow durations, 100ms by default
+	FailDuration time.Duration // red durations, 1s by default
+}
+
+// Handler is the slog.Handler rendering SQL records for a console.
+type Handler struct {
+	opts   Options
+	state  *state
+	attrs  []slog.Attr // preformatted attributes
+	group  string      // group prefix of the attribute keys
+	connID string      // connection of the handler attributes
+}
+
+// state is shared by the handler and its copies.
+type state struct {
+	mu  sync.Mutex
+	w   io.Writer
+	txs map[string]string // transaction in progress of the connection
+}
+
+// New returns a new console Handler writing to w.
+func New(w io.Writer, opts *Options) *Handler {
+	h := &Handler{state: &state{w: w, txs: make(map[string]string)}}
+	if opts != nil {
+		h.opts = *opts
+	}
+
+	if h.opts.Level == nil {
+		h.opts.Level = slog.LevelInfo
+	}
+
+	if h.opts.TimeFormat == "" {
+		h.opts.TimeFormat = "15:04:05.000"
+	}
+
+	if h.opts.SlowDuration == 0 {
+		h.opts.SlowDuration = 100 * time.Millisecond
+	}
+
+	if h.opts.FailDuration == 0 {
+		h.opts.FailDuration = time.Second
+	}
+
+	return h
+}
+
+var _ slog.Handler = (*Handler)(nil)
+
+// Enabled reports whether the handler handles records at the given level.
+func (h *Handler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.opts.Level.Level()
+}
+
+// WithAttrs returns a new Handler whose attributes consist of
+// both the receiver's attributes and the arguments.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	h2 := *h
+	h2.attrs = h.attrs[:len(h.attrs):len(h.attrs)]
+
+	for _, attr := range attrs {
+		if attr.Key == "connID" {
+			h2.connID = attr.Value.String() // of the sqlog logger, also in a group
+			continue
+		}
+
+		h2.attrs = append(h2.attrs, h.prefixed(attr))
+	}
+
+	return &h2
+}
+
+// WithGroup returns a new Handler with the given group appended to
+// the receiver's existing groups.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	h2 := *h
+	h2.group = h.group + name + "."
+
+	return &h2
+}
+
+func (h *Handler) prefixed(attr slog.Attr) slog.Attr {
+	attr.Key = h.group + attr.Key
+	return attr
+}
+
+// entry is the record with the known SQL attributes.
+type entry struct {
+	query    string
+	args     slog.Value
+	duration time.Duration
+	hasDur   bool
+	connID   string
+	txID     string
+	err      string
+	attrs    []slog.Attr
+}
+
+// Handle renders the record.
+func (h *Handler) Handle(_ context.Context, r slog.Record) error {
+	e := entry{connID: h.connID}
+
+	// the SQL attributes are recognized by their keys without the group,
+	// as sqlog logs to the handler with the groups of the application
+	collect := func(attr slog.Attr) bool {
+		if attr.Equal(slog.Attr{}) {
+			return true // empty attributes are ignored
+		}
+
+		attr.Value = attr.Value.Resolve()
+
+		switch attr.Key {
+		case "query":
+			e.query = attr.Value.String()
+		case "args":
+			e.args = attr.Value
+		case "duration":
+			if attr.Value.Kind() != slog.KindDuration {
+				e.attrs = append(e.attrs, h.prefixed(attr)) // not of sqlog
+				break
+			}
+
+			e.duration, e.hasDur = attr.Value.Duration(), true
+		case "connID":
+			e.connID = attr.Value.String()
+		case "txID":
+			e.txID = attr.Value.String()
+		case "error":
+			e.err = attr.Value.String()
+		default:
+			e.attrs = append(e.attrs, h.prefixed(attr))
+		}
+
+		return true
+	}
+
+	for _, attr := range h.attrs {
+		if !attr.Equal(slog.Attr{}) {
+			attr.Value = attr.Value.Resolve()
+			e.attrs = append(e.attrs, attr) // already prefixed
+		}
+	}
+
+	r.Attrs(collect)
+
+	h.state.mu.Lock()
+	defer h.state.mu.Unlock()
+
+	first, gutter := h.txGutter(r.Message, &e)
+
+	var buf bytes.Buffer
+
+	buf.WriteString(first)
+	buf.WriteString(h.color(colorGray, r.Time.Format(h.opts.TimeFormat)))
+	buf.WriteByte(' ')
+	buf.WriteString(h.level(r.Level))
+	buf.WriteByte(' ')
+	buf.WriteString(h.color(colorBold, r.Message))
+
+	if e.hasDur {
+		buf.WriteByte(' ')
+		buf.WriteString(h.duration(e.duration))
+	}
+
+	for _, attr := range [...]slog.Attr{
+		slog.String("connID", e.connID), slog.String("txID", e.txID),
+	} {
+		if attr.Value.String() != "" {
+			buf.WriteString(h.color(colorGray, " "+attr.Key+"="+attr.Value.String()))
+		}
+	}
+
+	for _, attr := range e.attrs {
+		buf.WriteString(h.color(colorGray, " "+attr.Key+"=") + attr.Value.String())
+	}
+
+	buf.WriteByte('\n')
+
+	const indent = "    "
+
+	if e.query != "" {
+		for _, line := range reindent(tokenize(e.query)) {
+			buf.WriteString(gutter + indent)
+			h.writeTokens(&buf, line)
+			buf.WriteByte('\n')
+		}
+	}
+
+	if args := formatArgs(e.args); args != "" {
+		buf.WriteString(gutter + indent + h.color(colorGray, "args: ") + args + "\n")
+	}
+
+	if e.err != "" {
+		buf.WriteString(gutter + indent + h.color(colorRed, "error: "+e.err) + "\n")
+	}
+
+	_, err := h.state.w.Write(buf.Bytes())
+
+	return err
+}
+
+// txGutter returns the prefixes of the first and next lines, marking
+// the records of the transaction in progress on the connection.
+func (h *Handler) txGutter(msg string, e *entry) (first, next string) {
+	switch {
+	case e.txID != "" && (strings.HasSuffix(msg, "begin") || strings.HasSuffix(msg, "beginTx")):
+		if e.connID != "" {
+			h.state.txs[e.connID] = e.txID
+		}
+
+		return h.color(txColor(e.txID), "┌ "), h.color(txColor(e.txID), "│ ")
+	case e.txID != "" && (strings.HasSuffix(msg, "commit") || strings.HasSuffix(msg, "rollback")):
+		delete(h.state.txs, e.connID)
+		return h.color(txColor(e.txID), "└ "), "  "
+	case e.connID != "":
+		txID, ok := h.state.txs[e.connID]
+		if !ok {
+			return "", ""
+		}
+
+		if e.txID == "" {
+			e.txID = txID
+		}
+
+		return h.color(txColor(txID), "│ "), h.color(txColor(txID), "│ ")
+	default:
+		return "", ""
+	}
+}
+
+func (h *Handler) writeTokens(buf *bytes.Buffer, tokens []token) {
+	for _, t := range tokens {
+		switch t.kind {
+		case tokenKeyword:
+			buf.WriteString(h.color(colorBlue, strings.ToUpper(t.text)))
+		case tokenString:
+			buf.WriteString(h.color(colorGreen, t.text))
+		case tokenNumber:
+			buf.WriteString(h.color(colorMagenta, t.text))
+		case tokenParam:
+			buf.WriteString(h.color(colorYellow, t.text))
+		case tokenComment:
+			buf.WriteString(h.color(colorGray, t.text))
+		default:
+			buf.WriteString(t.text)
+		}
+	}
+}
+
+func (h *Handler) level(level slog.Level) string {
+	switch {
+	case level >= slog.LevelError:
+		return h.color(colorRed, "ERR")
+	case level >= slog.LevelWarn:
+		return h.color(colorYellow, "WRN")
+	case level >= slog.LevelInfo:
+		return h.color(colorCyan, "INF")
+	default:
+		return h.color(colorGray, "DBG")
+	}
+}
+
+func (h *Handler) duration(d time.Duration) string {
+	switch {
+	case d >= h.opts.FailDuration:
+		return h.color(colorRed, d.String())
+	case d >= h.opts.SlowDuration:
+		return h.color(colorYellow, d.String())
+	default:
+		return h.color(colorGreen, d.String())
+	}
+}
+
+const (
+	colorRed     = "31"
+	colorGreen   = "32"
+	colorYellow  = "33"
+	colorBlue    = "34"
+	colorMagenta = "35"
+	colorCyan    = "36"
+	colorGray    = "90"
+	colorBold    = "1"
+)
+
+// txColors are the colors of transaction gutters.
+var txColors = [...]string{"31", "32", "33", "34", "35", "36", "91", "92", "93", "94", "95", "96"}
+
+// txColor returns the color of the transaction.
+func txColor(txID string) string {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(txID))
+
+	return txColors[hash.Sum32()%uint32(len(txColors))]
+}
+
+func (h *Handler) color(code, s string) string {
+	if h.opts.NoColor || s == "" {
+		return s
+	}
+
+	return "\x1b[" + code + "m" + s + "\x1b[0m"
+}
+
+// formatArgs returns the comma-separated list of the query arguments.
+func formatArgs(v slog.Value) string {
+	switch v.Kind() {
+	case slog.KindAny:
+	case slog.KindGroup:
+		return ""
+	default:
+		return v.String()
+	}
+
+	if v.Any() == nil {
+		return ""
+	}
+
+	args := reflect.ValueOf(v.Any())
+	if args.Kind() != reflect.Slice || args.Type().Elem().Kind() == reflect.Uint8 {
+		return fmt.Sprint(v.Any())
+	}
+
+	parts := make([]string, args.Len())
+	for i := range parts {
+		parts[i] = fmt.Sprintf("%#v", args.Index(i).Interface())
+	}
+
+	return strings.Join(parts, ", ")
+}
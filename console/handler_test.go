@@ -0,0 +1,76 @@
+package console
+
+import (
+	"bytes"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandlerDuration(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := slog.New(New(&buf, &Options{NoColor: true}))
+	logger.Info("done", "duration", "5s")
+	logger.Info("sql:exec", "duration", 5*time.Millisecond)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2:\n%s", len(lines), buf.String())
+	}
+
+	if !strings.HasSuffix(lines[0], "done duration=5s") {
+		t.Errorf("not a duration is logged as %q", lines[0])
+	}
+
+	if !strings.HasSuffix(lines[1], "sql:exec 5ms") {
+		t.Errorf("duration is logged as %q", lines[1])
+	}
+}
+
+func TestHandlerTransaction(t *testing.T) {
+	var buf bytes.Buffer
+
+	conn := slog.New(New(&buf, &Options{NoColor: true, TimeFormat: "-"})).With("connID", "c1")
+	conn.Info("sql:beginTx", "txID", "t1")
+	conn.Info("sql:execContext", "query", "UPDATE users SET name = ? WHERE id = ?",
+		"args", []any{"alice", 1}, "duration", time.Millisecond)
+	conn.Info("sql:tx:commit", "txID", "t1")
+	conn.Info("sql:queryContext", "query", "SELECT 1")
+
+	want := []string{
+		"┌ - INF sql:beginTx connID=c1 txID=t1",
+		"│ - INF sql:execContext 1ms connID=c1 txID=t1",
+		"│     UPDATE users",
+		"│     SET name = ?",
+		"│     WHERE id = ?",
+		`│     args: "alice", 1`,
+		"└ - INF sql:tx:commit connID=c1 txID=t1",
+		"- INF sql:queryContext connID=c1",
+		"    SELECT 1",
+	}
+
+	if got := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n"); !slices.Equal(got, want) {
+		t.Errorf("output:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestHandlerGroup(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := slog.New(New(&buf, &Options{NoColor: true, TimeFormat: "-"})).
+		WithGroup("db").With("connID", "c1", "host", "localhost")
+	logger.Info("sql:execContext", "query", "DELETE FROM users", "duration", time.Millisecond, "rows", 2)
+
+	want := []string{
+		"- INF sql:execContext 1ms connID=c1 db.host=localhost db.rows=2",
+		"    DELETE",
+		"    FROM users",
+	}
+
+	if got := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n"); !slices.Equal(got, want) {
+		t.Errorf("output:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
@@ -0,0 +1,237 @@
+package console
+
+import (
+	"strings"
+	"unicode"
+)
+
+// tokenKind is the kind of SQL token.
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenKeyword
+	tokenString
+	tokenNumber
+	tokenParam
+	tokenComment
+	tokenPunct
+	tokenSpace
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+var keywords = map[string]bool{}
+
+func init() {
+	for _, kw := range strings.Fields(`
+		ADD ALL ALTER AND ANY AS ASC BEGIN BETWEEN BY CASE CAST COMMIT CONFLICT
+		CREATE CROSS DEFAULT DELETE DESC DISTINCT DO DROP ELSE END EXCEPT EXISTS
+		FALSE FETCH FOR FROM FULL GROUP HAVING ILIKE IN INDEX INNER INSERT INTERSECT
+		INTO IS JOIN KEY LEFT LIKE LIMIT NOT NOTHING NULL OFFSET ON OR ORDER OUTER
+		OVER PARTITION PRIMARY REPLACE RETURNING RIGHT ROLLBACK ROW ROWS SELECT SET
+		TABLE THEN TRUE UNION UNIQUE UPDATE USING VALUES WHEN WHERE WINDOW WITH`) {
+		keywords[kw] = true
+	}
+}
+
+// clauses start a new line when the query is reindented.
+var clauses = map[string]bool{
+	"SELECT": true, "FROM": true, "WHERE": true, "GROUP": true, "ORDER": true,
+	"HAVING": true, "LIMIT": true, "OFFSET": true, "UNION": true, "EXCEPT": true,
+	"INTERSECT": true, "VALUES": true, "SET": true, "RETURNING": true,
+	"INSERT": true, "UPDATE": true, "DELETE": true, "WITH": true, "ON": true,
+	"JOIN": true, "LEFT": true, "RIGHT": true, "INNER": true, "FULL": true,
+	"CROSS": true, "AND": true, "OR": true,
+}
+
+// tokenize splits the query into tokens.
+func tokenize(query string) []token {
+	var tokens []token
+
+	for i := 0; i < len(query); {
+		c := query[i]
+		start := i
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			for i < len(query) && strings.IndexByte(" \t\r\n", query[i]) >= 0 {
+				i++
+			}
+
+			tokens = append(tokens, token{tokenSpace, " "})
+
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			i++
+			for i < len(query) {
+				if query[i] == c {
+					if i+1 < len(query) && query[i+1] == c { // escaped quote
+						i += 2
+						continue
+					}
+
+					i++
+
+					break
+				}
+				i++
+			}
+
+			kind := tokenString
+			if c != '\'' {
+				kind = tokenWord // quoted identifier
+			}
+
+			tokens = append(tokens, token{kind, query[start:i]})
+
+			continue
+		case c == '-' && i+1 < len(query) && query[i+1] == '-':
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			if end := strings.Index(query[i+2:], "*/"); end >= 0 {
+				i += end + 4
+			} else {
+				i = len(query)
+			}
+		case c == '?':
+			i++
+		case (c == '$' || c == ':' || c == '@') && i+1 < len(query) && isWordByte(query[i+1]):
+			if c == ':' && i > 0 && query[i-1] == ':' { // postgres cast
+				i++
+				tokens = append(tokens, token{tokenPunct, query[start:i]})
+
+				continue
+			}
+
+			i++
+			for i < len(query) && isWordByte(query[i]) {
+				i++
+			}
+		case c >= '0' && c <= '9':
+			for i < len(query) && (isWordByte(query[i]) || query[i] == '.') {
+				i++
+			}
+
+			tokens = append(tokens, token{tokenNumber, query[start:i]})
+
+			continue
+		case isWordByte(c):
+			for i < len(query) && isWordByte(query[i]) {
+				i++
+			}
+
+			kind := tokenWord
+			if keywords[strings.ToUpper(query[start:i])] {
+				kind = tokenKeyword
+			}
+
+			tokens = append(tokens, token{kind, query[start:i]})
+
+			continue
+		default:
+			i++
+			tokens = append(tokens, token{tokenPunct, query[start:i]})
+
+			continue
+		}
+
+		kind := tokenParam
+		if c == '-' || c == '/' {
+			kind = tokenComment
+		}
+
+		tokens = append(tokens, token{kind, query[start:i]})
+	}
+
+	return tokens
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || c >= 0x80 || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c))
+}
+
+// reindent returns the lines of the query with the clauses on new lines.
+// The clauses of subqueries are indented by the parentheses depth.
+func reindent(tokens []token) [][]token {
+	var (
+		lines [][]token
+		line  []token
+		depth int
+		prev  string
+	)
+
+	for i, t := range tokens {
+		switch t.kind {
+		case tokenSpace:
+			if len(line) == 0 {
+				continue
+			}
+		case tokenPunct:
+			switch t.text {
+			case "(":
+				depth++
+			case ")":
+				if depth > 0 {
+					depth--
+				}
+			}
+		case tokenKeyword:
+			word := strings.ToUpper(t.text)
+			if clauses[word] && !joinedClause(prev, word) && i > 0 && len(line) > 0 {
+				lines = append(lines, trimSpace(line))
+				line = nil
+
+				indent := strings.Repeat("  ", depth)
+				if word == "AND" || word == "OR" || word == "ON" {
+					indent += "  "
+				}
+
+				if indent != "" {
+					line = append(line, token{tokenSpace, indent})
+				}
+			}
+
+			prev = word
+		}
+
+		line = append(line, t)
+	}
+
+	if line = trimSpace(line); len(line) > 0 {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// joinedClause reports whether the keyword continues the previous one,
+// like JOIN after LEFT or AND after BETWEEN.
+func joinedClause(prev, word string) bool {
+	switch word {
+	case "JOIN":
+		return prev == "LEFT" || prev == "RIGHT" || prev == "INNER" ||
+			prev == "FULL" || prev == "CROSS" || prev == "OUTER"
+	case "AND":
+		return prev == "BETWEEN"
+	case "SELECT":
+		return prev == "UNION" || prev == "ALL" || prev == "EXCEPT" || prev == "INTERSECT"
+	case "UPDATE":
+		return prev == "FOR" || prev == "DO"
+	default:
+		return false
+	}
+}
+
+func trimSpace(line []token) []token {
+	for len(line) > 0 && line[len(line)-1].kind == tokenSpace {
+		line = line[:len(line)-1]
+	}
+
+	return line
+}
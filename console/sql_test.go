@@ -0,0 +1,93 @@
+package console
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	query := "SELECT name, 'it''s' FROM users -- all\nWHERE id = $1 AND n > 1.5 AND t::text = ? /* c */"
+
+	var kinds []tokenKind
+
+	var texts []string
+
+	for _, tok := range tokenize(query) {
+		if tok.kind == tokenSpace {
+			continue
+		}
+
+		kinds = append(kinds, tok.kind)
+		texts = append(texts, tok.text)
+	}
+
+	wantTexts := []string{
+		"SELECT", "name", ",", "'it''s'", "FROM", "users", "-- all",
+		"WHERE", "id", "=", "$1", "AND", "n", ">", "1.5", "AND", "t", ":", ":", "text", "=", "?", "/* c */",
+	}
+	wantKinds := []tokenKind{
+		tokenKeyword, tokenWord, tokenPunct, tokenString, tokenKeyword, tokenWord, tokenComment,
+		tokenKeyword, tokenWord, tokenPunct, tokenParam, tokenKeyword, tokenWord, tokenPunct, tokenNumber,
+		tokenKeyword, tokenWord, tokenPunct, tokenPunct, tokenWord, tokenPunct, tokenParam, tokenComment,
+	}
+
+	if !reflect.DeepEqual(texts, wantTexts) {
+		t.Errorf("tokens:\n%q\nwant:\n%q", texts, wantTexts)
+	}
+
+	if !reflect.DeepEqual(kinds, wantKinds) {
+		t.Errorf("kinds = %v, want %v", kinds, wantKinds)
+	}
+}
+
+func TestReindent(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{
+			"select id, name from users where age between 18 and 65 and active order by name",
+			[]string{
+				"select id, name",
+				"from users",
+				"where age between 18 and 65",
+				"  and active",
+				"order by name",
+			},
+		},
+		{
+			"SELECT * FROM a LEFT JOIN b ON a.id = b.id WHERE a.id IN (SELECT id FROM c)",
+			[]string{
+				"SELECT *",
+				"FROM a",
+				"LEFT JOIN b",
+				"  ON a.id = b.id",
+				"WHERE a.id IN (",
+				"  SELECT id",
+				"  FROM c)",
+			},
+		},
+		{
+			"SELECT 1 UNION ALL SELECT 2",
+			[]string{"SELECT 1", "UNION ALL SELECT 2"},
+		},
+	}
+
+	for _, tt := range tests {
+		var lines []string
+
+		for _, line := range reindent(tokenize(tt.query)) {
+			var b strings.Builder
+			for _, tok := range line {
+				b.WriteString(tok.text)
+			}
+
+			lines = append(lines, b.String())
+		}
+
+		if !reflect.DeepEqual(lines, tt.want) {
+			t.Errorf("reindent(%q):\n%q\nwant:\n%q", tt.query, lines, tt.want)
+		}
+	}
+}
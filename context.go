@@ -0,0 +1,44 @@
+package sqlog
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mdigger/sqlog/internal"
+)
+
+// ContextWithLogger returns a copy of ctx that reroutes the logging of all
+// SQL operations executed with it to log. The transaction begun and
+// the statement prepared with it are also logged to log on commit,
+// rollback and close.
+func ContextWithLogger(ctx context.Context, log *slog.Logger) context.Context {
+	return internal.ContextWithLogger(ctx, log)
+}
+
+// ContextWithLevel returns a copy of ctx that overrides the base level
+// for the logging of all SQL operations executed with it, including
+// the commit, rollback and close of the transaction or statement
+// started with it.
+func ContextWithLevel(ctx context.Context, level slog.Level) context.Context {
+	return internal.ContextWithLevel(ctx, level)
+}
+
+// Silence returns a copy of ctx that disables the logging of all
+// SQL operations executed with it. The transaction begun with it stays
+// silent until the commit or rollback, the prepared statement until close.
+func Silence(ctx context.Context) context.Context {
+	return internal.Silence(ctx)
+}
+
+// ContextWithRetry returns a copy of ctx, which marks read-only queries
+// executed with it as idempotent and safe to retry (see WithRetry).
+func ContextWithRetry(ctx context.Context) context.Context {
+	return internal.ContextWithRetry(ctx)
+}
+
+// ContextWithSQLComment returns a copy of ctx with additional tags for the
+// SQL comments enabled by WithSQLComment, such as route or controller.
+// Tags are given as key/value pairs.
+func ContextWithSQLComment(ctx context.Context, keyValues ...string) context.Context {
+	return internal.ContextWithComment(ctx, keyValues...)
+}
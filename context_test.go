@@ -0,0 +1,118 @@
+package sqlog
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/mdigger/sqlog/sqlogtest"
+	"github.com/mdigger/sqlog/sqlogtest/fakedriver"
+)
+
+func TestContextWithLogger(t *testing.T) {
+	db, _, h := openFake(t, &fakedriver.Options{Interfaces: fakedriver.All})
+
+	other := sqlogtest.NewHandler()
+	ctx := ContextWithLogger(context.Background(), slog.New(other))
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM users"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	h.AssertNoQueries(t)
+
+	other.ExpectBegin(t)
+	other.ExpectExec(t, "^DELETE FROM users")
+	other.ExpectCommit(t)
+	other.AssertNoQueries(t)
+}
+
+func TestContextWithLevel(t *testing.T) {
+	db, _, h := openFake(t, &fakedriver.Options{Interfaces: fakedriver.All})
+
+	ctx := ContextWithLevel(context.Background(), slog.LevelDebug)
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.ExecContext(context.Background(), "DELETE FROM users"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		record sqlogtest.Record
+		want   slog.Level
+	}{
+		{h.ExpectBegin(t), slog.LevelDebug},
+		{h.ExpectExec(t, "^DELETE FROM users"), slog.LevelInfo},
+		{h.ExpectRollback(t), slog.LevelDebug},
+	} {
+		if tt.record.Level != tt.want {
+			t.Errorf("%s level = %v, want %v", tt.record.Op, tt.record.Level, tt.want)
+		}
+	}
+}
+
+func TestSilence(t *testing.T) {
+	db, _, h := openFake(t, &fakedriver.Options{Interfaces: fakedriver.All})
+
+	ctx := Silence(context.Background())
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM users"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	stmt, err := db.PrepareContext(ctx, "SELECT id FROM users")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := stmt.QueryContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows.Close()
+
+	if err := stmt.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range h.Records() {
+		if r.Op != "isValid" { // pool check without context
+			t.Errorf("silenced operation is logged: %+v", r)
+		}
+	}
+
+	// not silenced operations are logged
+	if _, err := db.Exec("DELETE FROM users"); err != nil {
+		t.Fatal(err)
+	}
+
+	h.ExpectExec(t, "^DELETE FROM users")
+	h.AssertNoQueries(t)
+}
@@ -0,0 +1,43 @@
+package sqlog
+
+import "github.com/mdigger/sqlog/internal"
+
+// ErrorClass is a category of database errors,
+// logged as the errorClass attribute.
+type ErrorClass = internal.ErrorClass
+
+// Known error classes.
+const (
+	ErrorClassConstraint    = internal.ErrorClassConstraint    // constraint violation
+	ErrorClassDeadlock      = internal.ErrorClassDeadlock      // deadlock detected
+	ErrorClassSerialization = internal.ErrorClassSerialization // serialization failure
+	ErrorClassConnection    = internal.ErrorClassConnection    // connection lost
+	ErrorClassTimeout       = internal.ErrorClassTimeout       // timeout exceeded
+	ErrorClassCanceled      = internal.ErrorClassCanceled      // operation canceled
+	ErrorClassSyntax        = internal.ErrorClassSyntax        // syntax error
+)
+
+// Classifier maps a driver error to its class and driver-specific code,
+// logged as the errorClass and errorCode attributes.
+// It returns false if the error is unknown to it.
+type Classifier = internal.Classifier
+
+// SQLStater is implemented by driver errors with SQLSTATE codes.
+// Such errors are classified by ClassifySQLState.
+type SQLStater = internal.SQLStater
+
+// ClassifyContext classifies context cancellation and deadline errors.
+func ClassifyContext(err error) (ErrorClass, string, bool) {
+	return internal.ClassifyContext(err)
+}
+
+// ClassifyBadConn classifies driver.ErrBadConn as a lost connection.
+func ClassifyBadConn(err error) (ErrorClass, string, bool) {
+	return internal.ClassifyBadConn(err)
+}
+
+// ClassifySQLState classifies errors implementing SQLStater
+// by their SQLSTATE code.
+func ClassifySQLState(err error) (ErrorClass, string, bool) {
+	return internal.ClassifySQLState(err)
+}
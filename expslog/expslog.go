@@ -0,0 +1,89 @@
+// Package expslog adapts golang.org/x/exp/slog loggers for sqlog,
+// which is built on the standard log/slog package.
+package expslog
+
+import (
+	"context"
+	"log/slog"
+
+	xslog "golang.org/x/exp/slog"
+
+	"github.com/mdigger/sqlog"
+)
+
+// WithLogger set the golang.org/x/exp/slog logger.
+func WithLogger(log *xslog.Logger) sqlog.Options {
+	return sqlog.WithLogger(slog.New(Handler(log.Handler())))
+}
+
+// Handler returns the log/slog handler, which passes records
+// to the golang.org/x/exp/slog handler h.
+func Handler(h xslog.Handler) slog.Handler {
+	return handler{h}
+}
+
+type handler struct {
+	h xslog.Handler
+}
+
+func (h handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.h.Enabled(ctx, xslog.Level(level))
+}
+
+func (h handler) Handle(ctx context.Context, r slog.Record) error {
+	xr := xslog.NewRecord(r.Time, xslog.Level(r.Level), r.Message, r.PC)
+	r.Attrs(func(attr slog.Attr) bool {
+		xr.AddAttrs(convertAttr(attr))
+		return true
+	})
+
+	return h.h.Handle(ctx, xr)
+}
+
+func (h handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	xattrs := make([]xslog.Attr, len(attrs))
+	for i, attr := range attrs {
+		xattrs[i] = convertAttr(attr)
+	}
+
+	return handler{h.h.WithAttrs(xattrs)}
+}
+
+func (h handler) WithGroup(name string) slog.Handler {
+	return handler{h.h.WithGroup(name)}
+}
+
+func convertAttr(attr slog.Attr) xslog.Attr {
+	return xslog.Attr{Key: attr.Key, Value: convertValue(attr.Value)}
+}
+
+func convertValue(v slog.Value) xslog.Value {
+	v = v.Resolve()
+
+	switch v.Kind() {
+	case slog.KindString:
+		return xslog.StringValue(v.String())
+	case slog.KindInt64:
+		return xslog.Int64Value(v.Int64())
+	case slog.KindUint64:
+		return xslog.Uint64Value(v.Uint64())
+	case slog.KindFloat64:
+		return xslog.Float64Value(v.Float64())
+	case slog.KindBool:
+		return xslog.BoolValue(v.Bool())
+	case slog.KindDuration:
+		return xslog.DurationValue(v.Duration())
+	case slog.KindTime:
+		return xslog.TimeValue(v.Time())
+	case slog.KindGroup:
+		group := v.Group()
+		attrs := make([]xslog.Attr, len(group))
+		for i, attr := range group {
+			attrs[i] = convertAttr(attr)
+		}
+
+		return xslog.GroupValue(attrs...)
+	default:
+		return xslog.AnyValue(v.Any())
+	}
+}
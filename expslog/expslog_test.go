@@ -0,0 +1,83 @@
+package expslog_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	xslog "golang.org/x/exp/slog"
+
+	"github.com/mdigger/sqlog/expslog"
+)
+
+type secret string
+
+func (secret) LogValue() slog.Value { return slog.StringValue("***") }
+
+// logExec logs the record with the converted attributes of all kinds.
+func logExec(h xslog.Handler) {
+	log := slog.New(expslog.Handler(h))
+	log.With("connID", "c1").WithGroup("sql").Info("exec",
+		slog.Duration("duration", 1500*time.Millisecond),
+		slog.Any("password", secret("qwerty")),
+		slog.Group("result", slog.Int64("rows", 2), slog.Bool("ok", true)),
+		slog.Attr{},
+		slog.Group("empty"),
+	)
+}
+
+func TestHandler(t *testing.T) {
+	var buf bytes.Buffer
+
+	logExec(xslog.NewJSONHandler(&buf))
+
+	var got map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	delete(got, "time")
+
+	want := map[string]any{
+		"level":  "INFO",
+		"msg":    "exec",
+		"connID": "c1",
+		"sql": map[string]any{
+			"duration": float64(1500 * time.Millisecond),
+			"password": "***",
+			"result":   map[string]any{"rows": float64(2), "ok": true},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("record = %v, want %v", got, want)
+	}
+}
+
+func TestHandlerText(t *testing.T) {
+	var buf bytes.Buffer
+
+	logExec(xslog.NewTextHandler(&buf))
+
+	want := `level=INFO msg=exec connID=c1 sql.duration=1.5s sql.password=*** sql.result.rows=2 sql.result.ok=true`
+	if got := strings.TrimSpace(buf.String()); !strings.HasSuffix(got, want) {
+		t.Errorf("record = %q, want suffix %q", got, want)
+	}
+}
+
+func TestHandlerEnabled(t *testing.T) {
+	h := expslog.Handler(xslog.HandlerOptions{Level: xslog.LevelWarn}.NewTextHandler(new(bytes.Buffer)))
+
+	if h.Enabled(context.Background(), slog.LevelInfo) {
+		t.Error("info is enabled for the warn level")
+	}
+
+	if !h.Enabled(context.Background(), slog.LevelError) {
+		t.Error("error is disabled for the warn level")
+	}
+}
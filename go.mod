@@ -1,5 +1,5 @@
 module github.com/mdigger/sqlog
 
-go 1.20
+go 1.21
 
 require golang.org/x/exp v0.0.0-20230321023759-10a507213a29
@@ -0,0 +1,116 @@
+package sqlog
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/mdigger/sqlog/internal"
+)
+
+// Conn is the driver connection wrapped by the package, e.g. got with
+// sql.Conn.Raw. It also implements the optional interfaces of the driver
+// connection.
+type Conn interface {
+	driver.Conn
+	ConnID() string      // identifier of the connection
+	TxID() string        // identifier of the transaction in progress, if any
+	Unwrap() driver.Conn // driver connection
+}
+
+// Stmt is the driver statement wrapped by the package.
+type Stmt interface {
+	driver.Stmt
+	ConnID() string      // identifier of the connection, if known
+	StmtID() string      // identifier of the statement
+	Unwrap() driver.Stmt // driver statement
+}
+
+// Tx is the driver transaction wrapped by the package.
+type Tx interface {
+	driver.Tx
+	ConnID() string    // identifier of the connection, if known
+	TxID() string      // identifier of the transaction
+	Unwrap() driver.Tx // driver transaction
+}
+
+var (
+	_ Conn = (*internal.Conn)(nil)
+	_ Stmt = (*internal.Stmt)(nil)
+	_ Tx   = (*internal.Tx)(nil)
+)
+
+// ConnID returns the connection identifier logged as connID.
+// The v is the wrapped Conn, Stmt or Tx, the context passed
+// to the slog.Handler with the record of the operation, or the context
+// returned by ContextWithIDs after the operations executed with it.
+// It returns an empty string, if the identifier is unknown.
+func ConnID(v any) string {
+	switch v := v.(type) {
+	case context.Context:
+		ids, _ := internal.IDsFromContext(v)
+		return ids.ConnID
+	case interface{ ConnID() string }:
+		return v.ConnID()
+	default:
+		return ""
+	}
+}
+
+// TxID returns the transaction identifier logged as txID.
+// The v is the wrapped Conn with the transaction in progress or Tx,
+// or the context as for ConnID.
+// It returns an empty string, if the identifier is unknown.
+func TxID(v any) string {
+	switch v := v.(type) {
+	case context.Context:
+		ids, _ := internal.IDsFromContext(v)
+		return ids.TxID
+	case interface{ TxID() string }:
+		return v.TxID()
+	default:
+		return ""
+	}
+}
+
+// StmtID returns the statement identifier logged as stmtID.
+// The v is the wrapped Stmt, or the context passed to the slog.Handler
+// with the record of the operation.
+// It returns an empty string, if the identifier is unknown.
+func StmtID(v any) string {
+	switch v := v.(type) {
+	case context.Context:
+		ids, _ := internal.IDsFromContext(v)
+		return ids.StmtID
+	case interface{ StmtID() string }:
+		return v.StmtID()
+	default:
+		return ""
+	}
+}
+
+// ContextWithIDs returns a copy of ctx that receives the identifiers of
+// the connection and transaction used by the last SQL operation executed
+// with it, so the application logs can carry the same connID and txID:
+//
+//	ctx = sqlog.ContextWithIDs(ctx)
+//	rows, err := db.QueryContext(ctx, query)
+//	...
+//	slog.InfoContext(ctx, "loaded", "connID", sqlog.ConnID(ctx))
+func ContextWithIDs(ctx context.Context) context.Context {
+	return internal.ContextWithIDs(ctx)
+}
+
+// BeginTx starts a transaction like db.BeginTx and returns it with
+// the context carrying the identifiers of its connection and transaction.
+// See ContextWithIDs.
+func BeginTx(ctx context.Context, db *sql.DB, opts *sql.TxOptions) (*sql.Tx, context.Context, error) {
+	ctx = ContextWithIDs(ctx)
+
+	tx, err := db.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, ctx, err
+	}
+
+	return tx, ctx, nil
+}
@@ -0,0 +1,168 @@
+package sqlog
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/mdigger/sqlog/sqlogtest"
+	"github.com/mdigger/sqlog/sqlogtest/fakedriver"
+)
+
+// idsHandler collects the identifiers from the contexts of the records.
+type idsHandler struct {
+	*sqlogtest.Handler
+	*handlerIDs
+}
+
+// handlerIDs are the identifiers shared by the handler and its copies.
+type handlerIDs struct {
+	mu  sync.Mutex
+	ids map[string][3]string // connID, txID and stmtID by the message
+}
+
+func (h idsHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return idsHandler{h.Handler.WithAttrs(attrs).(*sqlogtest.Handler), h.handlerIDs}
+}
+
+func (h idsHandler) Handle(ctx context.Context, r slog.Record) error {
+	h.mu.Lock()
+	h.ids[r.Message] = [3]string{ConnID(ctx), TxID(ctx), StmtID(ctx)}
+	h.mu.Unlock()
+
+	return h.Handler.Handle(ctx, r)
+}
+
+func TestContextWithIDs(t *testing.T) {
+	db, _, h := openFake(t, &fakedriver.Options{Interfaces: fakedriver.All})
+
+	if id := ConnID(context.Background()); id != "" {
+		t.Errorf("ConnID() of the context without identifiers = %q", id)
+	}
+
+	ctx := ContextWithIDs(context.Background())
+
+	rows, err := db.QueryContext(ctx, "SELECT id FROM users")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows.Close()
+
+	r := h.ExpectQuery(t, "FROM users")
+	if ConnID(ctx) != r.ConnID || TxID(ctx) != "" {
+		t.Errorf("ConnID(), TxID() = %q, %q, want %q, empty", ConnID(ctx), TxID(ctx), r.ConnID)
+	}
+}
+
+func TestBeginTx(t *testing.T) {
+	db, _, h := openFake(t, &fakedriver.Options{Interfaces: fakedriver.All})
+
+	tx, ctx, err := BeginTx(context.Background(), db, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM users"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	begin := h.ExpectBegin(t)
+	if begin.TxID == "" || ConnID(ctx) != begin.ConnID || TxID(ctx) != begin.TxID {
+		t.Errorf("ConnID(), TxID() = %q, %q, want %q, %q", ConnID(ctx), TxID(ctx), begin.ConnID, begin.TxID)
+	}
+}
+
+func TestBeginTxError(t *testing.T) {
+	failed := errors.New("failed")
+	db, _, h := openFake(t, &fakedriver.Options{
+		Interfaces: fakedriver.All,
+		Err: func(call, _ string) error {
+			if call == "Conn.BeginTx" {
+				return failed
+			}
+
+			return nil
+		},
+	})
+
+	tx, ctx, err := BeginTx(context.Background(), db, nil)
+	if !errors.Is(err, failed) || tx != nil {
+		t.Fatalf("BeginTx() = %v, %v, want nil, %v", tx, err, failed)
+	}
+
+	if r := h.ExpectBegin(t); ConnID(ctx) != r.ConnID {
+		t.Errorf("ConnID() = %q, want %q", ConnID(ctx), r.ConnID)
+	}
+}
+
+func TestIDsFromHandlerContext(t *testing.T) {
+	h := idsHandler{sqlogtest.NewHandler(), &handlerIDs{ids: make(map[string][3]string)}}
+	db, _, _ := openFake(t, &fakedriver.Options{Interfaces: fakedriver.All}, WithHandler(h))
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stmt, err := tx.Prepare("DELETE FROM users WHERE id = ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := stmt.Exec(1); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	begin := h.ExpectBegin(t)
+	exec := h.ExpectExec(t, "^DELETE FROM users")
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if want := [3]string{begin.ConnID, begin.TxID, exec.StmtID}; exec.StmtID == "" || h.ids[exec.Message] != want {
+		t.Errorf("identifiers of %s = %q, want %q", exec.Message, h.ids[exec.Message], want)
+	}
+
+	if want := [3]string{begin.ConnID, begin.TxID, ""}; h.ids[begin.Message] != want {
+		t.Errorf("identifiers of %s = %q, want %q", begin.Message, h.ids[begin.Message], want)
+	}
+}
+
+func TestIDsOfWrapped(t *testing.T) {
+	db, _, _ := openFake(t, &fakedriver.Options{Interfaces: fakedriver.All})
+
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if err := conn.Raw(func(driverConn any) error {
+		if id := ConnID(driverConn); len(id) != 12 {
+			t.Errorf("ConnID() = %q", id)
+		}
+
+		if id := TxID(driverConn); id != "" {
+			t.Errorf("TxID() without transaction = %q", id)
+		}
+
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if id := ConnID("unknown"); id != "" {
+		t.Errorf("ConnID() of unknown value = %q", id)
+	}
+}
@@ -0,0 +1,266 @@
+package sqlog_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mdigger/sqlog"
+	"github.com/mdigger/sqlog/sqlogtest/fakedriver"
+)
+
+// The optional interfaces of the connections and statements.
+const (
+	connAll = fakedriver.Validator<<1 - 1
+	stmtAll = fakedriver.All &^ connAll
+)
+
+var matrixDrivers atomic.Int64
+
+// openMatrix opens the database of the new fake driver with the interfaces
+// without and with the logging, limited to a single connection.
+func openMatrix(t *testing.T, interfaces fakedriver.Interface) (raw, logged *sql.DB, d *fakedriver.Driver) {
+	t.Helper()
+
+	d = fakedriver.New(&fakedriver.Options{
+		Interfaces: interfaces,
+		Rows: func(string, []driver.NamedValue) ([]string, [][]driver.Value) {
+			return []string{"id"}, [][]driver.Value{{int64(1)}}
+		},
+	})
+	name := fmt.Sprintf("fakematrix%d", matrixDrivers.Add(1))
+	sql.Register(name, d)
+
+	raw, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logged, err = sqlog.Open(name, "", sqlog.WithHandler(disabledHandler{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, db := range []*sql.DB{raw, logged} {
+		db.SetMaxOpenConns(1)
+		t.Cleanup(func() { db.Close() })
+	}
+
+	return raw, logged, d
+}
+
+func implements[T any](v any) bool {
+	_, ok := v.(T)
+	return ok
+}
+
+// connInterfaces returns the optional interfaces of the connection.
+func connInterfaces(c driver.Conn) fakedriver.Interface {
+	checks := []struct {
+		i  fakedriver.Interface
+		ok bool
+	}{
+		{fakedriver.Pinger, implements[driver.Pinger](c)},
+		{fakedriver.Legacy, implements[driver.Execer](c) && implements[driver.Queryer](c)}, //nolint:staticcheck // checked
+		{fakedriver.ExecerContext, implements[driver.ExecerContext](c)},
+		{fakedriver.QueryerContext, implements[driver.QueryerContext](c)},
+		{fakedriver.ConnPrepareContext, implements[driver.ConnPrepareContext](c)},
+		{fakedriver.ConnBeginTx, implements[driver.ConnBeginTx](c)},
+		{fakedriver.SessionResetter, implements[driver.SessionResetter](c)},
+		{fakedriver.NamedValueChecker, implements[driver.NamedValueChecker](c)},
+		{fakedriver.Validator, implements[driver.Validator](c)},
+	}
+
+	var i fakedriver.Interface
+
+	for _, check := range checks {
+		if check.ok {
+			i |= check.i
+		}
+	}
+
+	return i
+}
+
+// stmtInterfaces returns the optional interfaces of the statement.
+func stmtInterfaces(s driver.Stmt) fakedriver.Interface {
+	checks := []struct {
+		i  fakedriver.Interface
+		ok bool
+	}{
+		{fakedriver.StmtExecContext, implements[driver.StmtExecContext](s)},
+		{fakedriver.StmtQueryContext, implements[driver.StmtQueryContext](s)},
+		{fakedriver.StmtNamedValueChecker, implements[driver.NamedValueChecker](s)},
+		{fakedriver.ColumnConverter, implements[driver.ColumnConverter](s)}, //nolint:staticcheck // checked
+	}
+
+	var i fakedriver.Interface
+
+	for _, check := range checks {
+		if check.ok {
+			i |= check.i
+		}
+	}
+
+	return i
+}
+
+// wrappedConnInterfaces returns the optional interfaces of the wrapped
+// connection. The context methods of preparing and beginning are always
+// implemented, falling back to the legacy ones, and the legacy Execer
+// and Queryer are implemented as their context versions.
+func wrappedConnInterfaces(i fakedriver.Interface) fakedriver.Interface {
+	want := i&^fakedriver.Legacy | fakedriver.ConnPrepareContext | fakedriver.ConnBeginTx
+
+	if i&fakedriver.Legacy != 0 {
+		want |= fakedriver.ExecerContext | fakedriver.QueryerContext
+	}
+
+	return want
+}
+
+// wrappedStmtInterfaces returns the optional interfaces of the wrapped
+// statement: the context methods are always implemented, falling back
+// to the legacy ones.
+func wrappedStmtInterfaces(i fakedriver.Interface) fakedriver.Interface {
+	return i | fakedriver.StmtExecContext | fakedriver.StmtQueryContext
+}
+
+// exercise calls the database methods using all the driver interfaces.
+func exercise(t *testing.T, db *sql.DB) {
+	t.Helper()
+
+	ctx := context.Background()
+	check := func(err error) {
+		t.Helper()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	query := func(rows *sql.Rows, err error) {
+		t.Helper()
+		check(err)
+
+		for rows.Next() {
+		}
+
+		check(rows.Close())
+	}
+
+	check(db.PingContext(ctx))
+
+	_, err := db.ExecContext(ctx, "UPDATE users SET name = ? WHERE id = ?", "alice", 1)
+	check(err)
+	query(db.QueryContext(ctx, "SELECT id FROM users WHERE name = ?", "alice"))
+
+	stmt, err := db.PrepareContext(ctx, "SELECT id FROM users WHERE id = ?")
+	check(err)
+
+	_, err = stmt.ExecContext(ctx, 1)
+	check(err)
+	query(stmt.QueryContext(ctx, 1))
+	check(stmt.Close())
+
+	tx, err := db.BeginTx(ctx, nil)
+	check(err)
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM users WHERE id = ?", 1)
+	check(err)
+	check(tx.Commit())
+
+	tx, err = db.Begin()
+	check(err)
+	check(tx.Rollback())
+}
+
+// checkFallback checks that database/sql calls the same driver methods
+// with and without the logging.
+func checkFallback(t *testing.T, raw, logged *sql.DB, d *fakedriver.Driver) {
+	t.Helper()
+
+	d.ResetCalls()
+	exercise(t, raw)
+	want := d.Calls()
+
+	d.ResetCalls()
+	exercise(t, logged)
+
+	if calls := d.Calls(); !slices.Equal(calls, want) {
+		t.Errorf("calls:\n%q\nwant:\n%q", calls, want)
+	}
+}
+
+func TestConnInterfaces(t *testing.T) {
+	for set := fakedriver.Interface(0); set <= connAll; set++ {
+		t.Run(fmt.Sprintf("%010b", set), func(t *testing.T) {
+			raw, logged, d := openMatrix(t, set|stmtAll)
+			checkFallback(t, raw, logged, d)
+
+			conn, err := logged.Conn(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = conn.Raw(func(driverConn any) error {
+				if got := connInterfaces(sqlog.Unwrap(driverConn)); got != set {
+					t.Errorf("driver interfaces = %010b, want %010b", got, set)
+				}
+
+				got, want := connInterfaces(driverConn.(driver.Conn)), wrappedConnInterfaces(set)
+				if got != want {
+					t.Errorf("wrapped interfaces = %010b, want %010b", got, want)
+				}
+
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			conn.Close()
+		})
+	}
+}
+
+func TestStmtInterfaces(t *testing.T) {
+	for set := fakedriver.Interface(0); set <= stmtAll; set += connAll + 1 {
+		t.Run(fmt.Sprintf("%04b", set>>10), func(t *testing.T) {
+			raw, logged, d := openMatrix(t, set|connAll)
+			checkFallback(t, raw, logged, d)
+
+			conn, err := logged.Conn(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = conn.Raw(func(driverConn any) error {
+				stmt, err := driverConn.(driver.ConnPrepareContext).PrepareContext(context.Background(), "SELECT 1")
+				if err != nil {
+					return err
+				}
+				defer stmt.Close()
+
+				if got := stmtInterfaces(sqlog.UnwrapStmt(stmt)); got != set {
+					t.Errorf("driver interfaces = %04b, want %04b", got>>10, set>>10)
+				}
+
+				got, want := stmtInterfaces(stmt), wrappedStmtInterfaces(set)
+				if got != want {
+					t.Errorf("wrapped interfaces = %04b, want %04b", got>>10, want>>10)
+				}
+
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			conn.Close()
+		})
+	}
+}
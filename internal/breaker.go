@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by Connector.Connect while the circuit
+// breaker is open.
+var ErrCircuitOpen = errors.New("sqlog: circuit breaker is open")
+
+// BreakerPolicy describes the circuit breaker of the connector.
+type BreakerPolicy struct {
+	Threshold int           // consecutive failures opening the circuit, zero disables it
+	CoolDown  time.Duration // duration of the open state before the probe
+}
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case breakerClosed:
+		return "closed"
+	case breakerOpen:
+		return "open"
+	case breakerHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// breaker is the circuit breaker: it opens after the threshold of
+// consecutive failures, fails fast during the cool-down and then allows
+// a single probe, which closes or opens it again.
+type breaker struct {
+	policy BreakerPolicy
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newBreaker(policy BreakerPolicy) *breaker {
+	if policy.Threshold <= 0 {
+		return nil
+	}
+
+	return &breaker{policy: policy}
+}
+
+// allow reports whether the attempt is allowed. The changed flag reports
+// the transition of the state.
+func (b *breaker) allow(now time.Time) (allowed, changed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if now.Sub(b.openedAt) < b.policy.CoolDown {
+			return false, false
+		}
+
+		b.state = breakerHalfOpen
+		b.probing = true
+
+		return true, true
+	case breakerHalfOpen:
+		if b.probing {
+			return false, false
+		}
+
+		b.probing = true
+
+		return true, false
+	default:
+		return true, false
+	}
+}
+
+// done records the result of the allowed attempt. The changed flag reports
+// the transition of the state.
+func (b *breaker) done(now time.Time, err error) (changed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+
+	if err == nil {
+		b.failures = 0
+		changed = b.state != breakerClosed
+		b.state = breakerClosed
+
+		return changed
+	}
+
+	b.failures++
+
+	if b.state == breakerHalfOpen || b.failures >= b.policy.Threshold {
+		changed = b.state != breakerOpen
+		b.state = breakerOpen
+		b.openedAt = now
+	}
+
+	return changed
+}
+
+// status returns the current state and the number of consecutive failures.
+func (b *breaker) status() (breakerState, int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state, b.failures
+}
@@ -0,0 +1,134 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mdigger/sqlog/sqlogtest/fakedriver"
+)
+
+func TestBreaker(t *testing.T) {
+	failed := errors.New("failed")
+	start := time.Now()
+
+	// steps of the single breaker with the threshold 2 and cool-down 10s
+	tests := []struct {
+		at       time.Duration // time of the step since the start
+		done     bool          // done with err instead of allow
+		err      error
+		allowed  bool
+		changed  bool
+		state    breakerState
+		failures int
+	}{
+		{at: 0, allowed: true, state: breakerClosed},
+		{at: 0, done: true, err: failed, state: breakerClosed, failures: 1},
+		{at: time.Second, allowed: true, state: breakerClosed, failures: 1},
+		{at: time.Second, done: true, err: failed, changed: true, state: breakerOpen, failures: 2},
+		{at: 5 * time.Second, state: breakerOpen, failures: 2}, // cool-down
+		{at: 11 * time.Second, allowed: true, changed: true, state: breakerHalfOpen, failures: 2},
+		{at: 11 * time.Second, state: breakerHalfOpen, failures: 2}, // single probe
+		{at: 12 * time.Second, done: true, err: failed, changed: true, state: breakerOpen, failures: 3},
+		{at: 21 * time.Second, state: breakerOpen, failures: 3}, // cool-down since the probe
+		{at: 22 * time.Second, allowed: true, changed: true, state: breakerHalfOpen, failures: 3},
+		{at: 22 * time.Second, done: true, changed: true, state: breakerClosed},
+		{at: 23 * time.Second, allowed: true, state: breakerClosed},
+		{at: 23 * time.Second, done: true, err: failed, state: breakerClosed, failures: 1},
+	}
+
+	b := newBreaker(BreakerPolicy{Threshold: 2, CoolDown: 10 * time.Second})
+
+	for i, tt := range tests {
+		now := start.Add(tt.at)
+
+		if tt.done {
+			if changed := b.done(now, tt.err); changed != tt.changed {
+				t.Errorf("%d: done(%v) changed = %v, want %v", i, tt.err, changed, tt.changed)
+			}
+		} else if allowed, changed := b.allow(now); allowed != tt.allowed || changed != tt.changed {
+			t.Errorf("%d: allow() = %v, %v, want %v, %v", i, allowed, changed, tt.allowed, tt.changed)
+		}
+
+		if state, failures := b.status(); state != tt.state || failures != tt.failures {
+			t.Errorf("%d: status() = %v, %d, want %v, %d", i, state, failures, tt.state, tt.failures)
+		}
+	}
+
+	if b := newBreaker(BreakerPolicy{}); b != nil {
+		t.Error("breaker without threshold is enabled")
+	}
+}
+
+func TestConnectorBreaker(t *testing.T) {
+	refused := errors.New("connection refused")
+
+	var down atomic.Bool
+	down.Store(true)
+
+	d := fakedriver.New(&fakedriver.Options{
+		Err: func(call, _ string) error {
+			if call == "Driver.Open" && down.Load() {
+				return refused
+			}
+
+			return nil
+		},
+	})
+
+	h := new(recordHandler)
+	c := NewConnector("", d, Logger{Handler: h, Config: &Config{
+		Breaker: BreakerPolicy{Threshold: 1, CoolDown: time.Hour},
+	}})
+
+	if _, err := c.Connect(context.Background()); !errors.Is(err, refused) {
+		t.Fatalf("error = %v, want %v", err, refused)
+	}
+
+	if _, err := c.Connect(context.Background()); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("error = %v, want %v", err, ErrCircuitOpen)
+	}
+
+	c.breaker.mu.Lock()
+	c.breaker.openedAt = c.breaker.openedAt.Add(-time.Hour) // the cool-down is over
+	c.breaker.mu.Unlock()
+
+	down.Store(false)
+
+	conn, err := c.Connect(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn.Close()
+
+	// the fast failure is not logged, the probe is logged with its transitions
+	want := []string{"circuit", "connect", "circuit", "circuit", "connect", "close"}
+	if msgs := h.Messages(); !slices.Equal(msgs, want) {
+		t.Fatalf("messages = %q, want %q", msgs, want)
+	}
+
+	var states []string
+
+	for _, r := range h.Records() {
+		if r.Message != "circuit" {
+			continue
+		}
+
+		r.Attrs(func(attr slog.Attr) bool {
+			if attr.Key == "state" {
+				states = append(states, attr.Value.String())
+			}
+
+			return true
+		})
+	}
+
+	if want := []string{"open", "half-open", "closed"}; !slices.Equal(states, want) {
+		t.Errorf("states = %q, want %q", states, want)
+	}
+}
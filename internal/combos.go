@@ -0,0 +1,521 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package internal
+
+import "database/sql/driver"
+
+// wrapConn returns c implementing the optional interfaces of its driver conn.
+func wrapConn(c *Conn) driver.Conn {
+	var set uint
+
+	if _, ok := c.conn.(driver.Pinger); ok {
+		set |= 1
+	}
+	if _, ok := c.conn.(driver.ExecerContext); ok {
+		set |= 2
+	}
+	if _, ok := c.conn.(driver.Execer); ok { //nolint:staticcheck // supported
+		set |= 2
+	}
+	if _, ok := c.conn.(driver.QueryerContext); ok {
+		set |= 4
+	}
+	if _, ok := c.conn.(driver.Queryer); ok { //nolint:staticcheck // supported
+		set |= 4
+	}
+	if _, ok := c.conn.(driver.SessionResetter); ok {
+		set |= 8
+	}
+	if _, ok := c.conn.(driver.NamedValueChecker); ok {
+		set |= 16
+	}
+	if _, ok := c.conn.(driver.Validator); ok {
+		set |= 32
+	}
+
+	switch set {
+	case 0:
+		return c
+	case 1: // pinger
+		return struct {
+			*Conn
+			pinger
+		}{c, pinger{c}}
+	case 2: // execer
+		return struct {
+			*Conn
+			execer
+		}{c, execer{c}}
+	case 3: // pinger, execer
+		return struct {
+			*Conn
+			pinger
+			execer
+		}{c, pinger{c}, execer{c}}
+	case 4: // queryer
+		return struct {
+			*Conn
+			queryer
+		}{c, queryer{c}}
+	case 5: // pinger, queryer
+		return struct {
+			*Conn
+			pinger
+			queryer
+		}{c, pinger{c}, queryer{c}}
+	case 6: // execer, queryer
+		return struct {
+			*Conn
+			execer
+			queryer
+		}{c, execer{c}, queryer{c}}
+	case 7: // pinger, execer, queryer
+		return struct {
+			*Conn
+			pinger
+			execer
+			queryer
+		}{c, pinger{c}, execer{c}, queryer{c}}
+	case 8: // sessionResetter
+		return struct {
+			*Conn
+			sessionResetter
+		}{c, sessionResetter{c}}
+	case 9: // pinger, sessionResetter
+		return struct {
+			*Conn
+			pinger
+			sessionResetter
+		}{c, pinger{c}, sessionResetter{c}}
+	case 10: // execer, sessionResetter
+		return struct {
+			*Conn
+			execer
+			sessionResetter
+		}{c, execer{c}, sessionResetter{c}}
+	case 11: // pinger, execer, sessionResetter
+		return struct {
+			*Conn
+			pinger
+			execer
+			sessionResetter
+		}{c, pinger{c}, execer{c}, sessionResetter{c}}
+	case 12: // queryer, sessionResetter
+		return struct {
+			*Conn
+			queryer
+			sessionResetter
+		}{c, queryer{c}, sessionResetter{c}}
+	case 13: // pinger, queryer, sessionResetter
+		return struct {
+			*Conn
+			pinger
+			queryer
+			sessionResetter
+		}{c, pinger{c}, queryer{c}, sessionResetter{c}}
+	case 14: // execer, queryer, sessionResetter
+		return struct {
+			*Conn
+			execer
+			queryer
+			sessionResetter
+		}{c, execer{c}, queryer{c}, sessionResetter{c}}
+	case 15: // pinger, execer, queryer, sessionResetter
+		return struct {
+			*Conn
+			pinger
+			execer
+			queryer
+			sessionResetter
+		}{c, pinger{c}, execer{c}, queryer{c}, sessionResetter{c}}
+	case 16: // namedValueChecker
+		return struct {
+			*Conn
+			namedValueChecker
+		}{c, namedValueChecker{c}}
+	case 17: // pinger, namedValueChecker
+		return struct {
+			*Conn
+			pinger
+			namedValueChecker
+		}{c, pinger{c}, namedValueChecker{c}}
+	case 18: // execer, namedValueChecker
+		return struct {
+			*Conn
+			execer
+			namedValueChecker
+		}{c, execer{c}, namedValueChecker{c}}
+	case 19: // pinger, execer, namedValueChecker
+		return struct {
+			*Conn
+			pinger
+			execer
+			namedValueChecker
+		}{c, pinger{c}, execer{c}, namedValueChecker{c}}
+	case 20: // queryer, namedValueChecker
+		return struct {
+			*Conn
+			queryer
+			namedValueChecker
+		}{c, queryer{c}, namedValueChecker{c}}
+	case 21: // pinger, queryer, namedValueChecker
+		return struct {
+			*Conn
+			pinger
+			queryer
+			namedValueChecker
+		}{c, pinger{c}, queryer{c}, namedValueChecker{c}}
+	case 22: // execer, queryer, namedValueChecker
+		return struct {
+			*Conn
+			execer
+			queryer
+			namedValueChecker
+		}{c, execer{c}, queryer{c}, namedValueChecker{c}}
+	case 23: // pinger, execer, queryer, namedValueChecker
+		return struct {
+			*Conn
+			pinger
+			execer
+			queryer
+			namedValueChecker
+		}{c, pinger{c}, execer{c}, queryer{c}, namedValueChecker{c}}
+	case 24: // sessionResetter, namedValueChecker
+		return struct {
+			*Conn
+			sessionResetter
+			namedValueChecker
+		}{c, sessionResetter{c}, namedValueChecker{c}}
+	case 25: // pinger, sessionResetter, namedValueChecker
+		return struct {
+			*Conn
+			pinger
+			sessionResetter
+			namedValueChecker
+		}{c, pinger{c}, sessionResetter{c}, namedValueChecker{c}}
+	case 26: // execer, sessionResetter, namedValueChecker
+		return struct {
+			*Conn
+			execer
+			sessionResetter
+			namedValueChecker
+		}{c, execer{c}, sessionResetter{c}, namedValueChecker{c}}
+	case 27: // pinger, execer, sessionResetter, namedValueChecker
+		return struct {
+			*Conn
+			pinger
+			execer
+			sessionResetter
+			namedValueChecker
+		}{c, pinger{c}, execer{c}, sessionResetter{c}, namedValueChecker{c}}
+	case 28: // queryer, sessionResetter, namedValueChecker
+		return struct {
+			*Conn
+			queryer
+			sessionResetter
+			namedValueChecker
+		}{c, queryer{c}, sessionResetter{c}, namedValueChecker{c}}
+	case 29: // pinger, queryer, sessionResetter, namedValueChecker
+		return struct {
+			*Conn
+			pinger
+			queryer
+			sessionResetter
+			namedValueChecker
+		}{c, pinger{c}, queryer{c}, sessionResetter{c}, namedValueChecker{c}}
+	case 30: // execer, queryer, sessionResetter, namedValueChecker
+		return struct {
+			*Conn
+			execer
+			queryer
+			sessionResetter
+			namedValueChecker
+		}{c, execer{c}, queryer{c}, sessionResetter{c}, namedValueChecker{c}}
+	case 31: // pinger, execer, queryer, sessionResetter, namedValueChecker
+		return struct {
+			*Conn
+			pinger
+			execer
+			queryer
+			sessionResetter
+			namedValueChecker
+		}{c, pinger{c}, execer{c}, queryer{c}, sessionResetter{c}, namedValueChecker{c}}
+	case 32: // validator
+		return struct {
+			*Conn
+			validator
+		}{c, validator{c}}
+	case 33: // pinger, validator
+		return struct {
+			*Conn
+			pinger
+			validator
+		}{c, pinger{c}, validator{c}}
+	case 34: // execer, validator
+		return struct {
+			*Conn
+			execer
+			validator
+		}{c, execer{c}, validator{c}}
+	case 35: // pinger, execer, validator
+		return struct {
+			*Conn
+			pinger
+			execer
+			validator
+		}{c, pinger{c}, execer{c}, validator{c}}
+	case 36: // queryer, validator
+		return struct {
+			*Conn
+			queryer
+			validator
+		}{c, queryer{c}, validator{c}}
+	case 37: // pinger, queryer, validator
+		return struct {
+			*Conn
+			pinger
+			queryer
+			validator
+		}{c, pinger{c}, queryer{c}, validator{c}}
+	case 38: // execer, queryer, validator
+		return struct {
+			*Conn
+			execer
+			queryer
+			validator
+		}{c, execer{c}, queryer{c}, validator{c}}
+	case 39: // pinger, execer, queryer, validator
+		return struct {
+			*Conn
+			pinger
+			execer
+			queryer
+			validator
+		}{c, pinger{c}, execer{c}, queryer{c}, validator{c}}
+	case 40: // sessionResetter, validator
+		return struct {
+			*Conn
+			sessionResetter
+			validator
+		}{c, sessionResetter{c}, validator{c}}
+	case 41: // pinger, sessionResetter, validator
+		return struct {
+			*Conn
+			pinger
+			sessionResetter
+			validator
+		}{c, pinger{c}, sessionResetter{c}, validator{c}}
+	case 42: // execer, sessionResetter, validator
+		return struct {
+			*Conn
+			execer
+			sessionResetter
+			validator
+		}{c, execer{c}, sessionResetter{c}, validator{c}}
+	case 43: // pinger, execer, sessionResetter, validator
+		return struct {
+			*Conn
+			pinger
+			execer
+			sessionResetter
+			validator
+		}{c, pinger{c}, execer{c}, sessionResetter{c}, validator{c}}
+	case 44: // queryer, sessionResetter, validator
+		return struct {
+			*Conn
+			queryer
+			sessionResetter
+			validator
+		}{c, queryer{c}, sessionResetter{c}, validator{c}}
+	case 45: // pinger, queryer, sessionResetter, validator
+		return struct {
+			*Conn
+			pinger
+			queryer
+			sessionResetter
+			validator
+		}{c, pinger{c}, queryer{c}, sessionResetter{c}, validator{c}}
+	case 46: // execer, queryer, sessionResetter, validator
+		return struct {
+			*Conn
+			execer
+			queryer
+			sessionResetter
+			validator
+		}{c, execer{c}, queryer{c}, sessionResetter{c}, validator{c}}
+	case 47: // pinger, execer, queryer, sessionResetter, validator
+		return struct {
+			*Conn
+			pinger
+			execer
+			queryer
+			sessionResetter
+			validator
+		}{c, pinger{c}, execer{c}, queryer{c}, sessionResetter{c}, validator{c}}
+	case 48: // namedValueChecker, validator
+		return struct {
+			*Conn
+			namedValueChecker
+			validator
+		}{c, namedValueChecker{c}, validator{c}}
+	case 49: // pinger, namedValueChecker, validator
+		return struct {
+			*Conn
+			pinger
+			namedValueChecker
+			validator
+		}{c, pinger{c}, namedValueChecker{c}, validator{c}}
+	case 50: // execer, namedValueChecker, validator
+		return struct {
+			*Conn
+			execer
+			namedValueChecker
+			validator
+		}{c, execer{c}, namedValueChecker{c}, validator{c}}
+	case 51: // pinger, execer, namedValueChecker, validator
+		return struct {
+			*Conn
+			pinger
+			execer
+			namedValueChecker
+			validator
+		}{c, pinger{c}, execer{c}, namedValueChecker{c}, validator{c}}
+	case 52: // queryer, namedValueChecker, validator
+		return struct {
+			*Conn
+			queryer
+			namedValueChecker
+			validator
+		}{c, queryer{c}, namedValueChecker{c}, validator{c}}
+	case 53: // pinger, queryer, namedValueChecker, validator
+		return struct {
+			*Conn
+			pinger
+			queryer
+			namedValueChecker
+			validator
+		}{c, pinger{c}, queryer{c}, namedValueChecker{c}, validator{c}}
+	case 54: // execer, queryer, namedValueChecker, validator
+		return struct {
+			*Conn
+			execer
+			queryer
+			namedValueChecker
+			validator
+		}{c, execer{c}, queryer{c}, namedValueChecker{c}, validator{c}}
+	case 55: // pinger, execer, queryer, namedValueChecker, validator
+		return struct {
+			*Conn
+			pinger
+			execer
+			queryer
+			namedValueChecker
+			validator
+		}{c, pinger{c}, execer{c}, queryer{c}, namedValueChecker{c}, validator{c}}
+	case 56: // sessionResetter, namedValueChecker, validator
+		return struct {
+			*Conn
+			sessionResetter
+			namedValueChecker
+			validator
+		}{c, sessionResetter{c}, namedValueChecker{c}, validator{c}}
+	case 57: // pinger, sessionResetter, namedValueChecker, validator
+		return struct {
+			*Conn
+			pinger
+			sessionResetter
+			namedValueChecker
+			validator
+		}{c, pinger{c}, sessionResetter{c}, namedValueChecker{c}, validator{c}}
+	case 58: // execer, sessionResetter, namedValueChecker, validator
+		return struct {
+			*Conn
+			execer
+			sessionResetter
+			namedValueChecker
+			validator
+		}{c, execer{c}, sessionResetter{c}, namedValueChecker{c}, validator{c}}
+	case 59: // pinger, execer, sessionResetter, namedValueChecker, validator
+		return struct {
+			*Conn
+			pinger
+			execer
+			sessionResetter
+			namedValueChecker
+			validator
+		}{c, pinger{c}, execer{c}, sessionResetter{c}, namedValueChecker{c}, validator{c}}
+	case 60: // queryer, sessionResetter, namedValueChecker, validator
+		return struct {
+			*Conn
+			queryer
+			sessionResetter
+			namedValueChecker
+			validator
+		}{c, queryer{c}, sessionResetter{c}, namedValueChecker{c}, validator{c}}
+	case 61: // pinger, queryer, sessionResetter, namedValueChecker, validator
+		return struct {
+			*Conn
+			pinger
+			queryer
+			sessionResetter
+			namedValueChecker
+			validator
+		}{c, pinger{c}, queryer{c}, sessionResetter{c}, namedValueChecker{c}, validator{c}}
+	case 62: // execer, queryer, sessionResetter, namedValueChecker, validator
+		return struct {
+			*Conn
+			execer
+			queryer
+			sessionResetter
+			namedValueChecker
+			validator
+		}{c, execer{c}, queryer{c}, sessionResetter{c}, namedValueChecker{c}, validator{c}}
+	case 63: // pinger, execer, queryer, sessionResetter, namedValueChecker, validator
+		return struct {
+			*Conn
+			pinger
+			execer
+			queryer
+			sessionResetter
+			namedValueChecker
+			validator
+		}{c, pinger{c}, execer{c}, queryer{c}, sessionResetter{c}, namedValueChecker{c}, validator{c}}
+	default:
+		panic("unreachable")
+	}
+}
+
+// wrapStmt returns s implementing the optional interfaces of its driver stmt.
+func wrapStmt(s *Stmt) driver.Stmt {
+	var set uint
+
+	if _, ok := s.stmt.(driver.NamedValueChecker); ok {
+		set |= 1
+	}
+	if _, ok := s.stmt.(driver.ColumnConverter); ok { //nolint:staticcheck // supported
+		set |= 2
+	}
+
+	switch set {
+	case 0:
+		return s
+	case 1: // stmtNamedValueChecker
+		return struct {
+			*Stmt
+			stmtNamedValueChecker
+		}{s, stmtNamedValueChecker{s}}
+	case 2: // columnConverter
+		return struct {
+			*Stmt
+			columnConverter
+		}{s, columnConverter{s}}
+	case 3: // stmtNamedValueChecker, columnConverter
+		return struct {
+			*Stmt
+			stmtNamedValueChecker
+			columnConverter
+		}{s, stmtNamedValueChecker{s}, columnConverter{s}}
+	default:
+		panic("unreachable")
+	}
+}
@@ -0,0 +1,112 @@
+package internal
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+type ctxCommentKey struct{}
+
+// Commenter appends sqlcommenter-style comments to queries.
+type Commenter struct {
+	Ops  Op                                          // operations with comments
+	Tags map[string]string                           // static tags, like application name
+	Func func(ctx context.Context) map[string]string // tags from the context, like traceparent
+}
+
+// ContextWithComment returns a copy of ctx with additional comment tags.
+// Tags are given as key/value pairs and override the tags with the same keys
+// already stored in ctx.
+func ContextWithComment(ctx context.Context, keyValues ...string) context.Context {
+	parent, _ := ctx.Value(ctxCommentKey{}).(map[string]string)
+	tags := make(map[string]string, len(parent)+len(keyValues)/2)
+
+	for k, v := range parent {
+		tags[k] = v
+	}
+
+	for i := 0; i+1 < len(keyValues); i += 2 {
+		tags[keyValues[i]] = keyValues[i+1]
+	}
+
+	return context.WithValue(ctx, ctxCommentKey{}, tags)
+}
+
+// Apply returns the query with the comment appended when op is enabled.
+// Queries which already contain a comment are returned unchanged.
+func (c Commenter) Apply(ctx context.Context, op Op, query string) string {
+	if !c.Ops.Has(op) || strings.Contains(query, "/*") {
+		return query
+	}
+
+	tags := make(map[string]string, len(c.Tags))
+	for k, v := range c.Tags {
+		tags[k] = v
+	}
+
+	if c.Func != nil {
+		for k, v := range c.Func(ctx) {
+			tags[k] = v
+		}
+	}
+
+	if ctxTags, ok := ctx.Value(ctxCommentKey{}).(map[string]string); ok {
+		for k, v := range ctxTags {
+			tags[k] = v
+		}
+	}
+
+	comment := formatComment(tags)
+	if comment == "" {
+		return query
+	}
+
+	// keep the terminating semicolon at the end of the statement
+	query = strings.TrimRight(query, " \t\r\n")
+	if strings.HasSuffix(query, ";") {
+		return query[:len(query)-1] + " " + comment + ";"
+	}
+
+	return query + " " + comment
+}
+
+// formatComment serializes tags sorted by key as /*key='value',...*/.
+func formatComment(tags map[string]string) string {
+	keys := make([]string, 0, len(tags))
+	for k, v := range tags {
+		if k != "" && v != "" {
+			keys = append(keys, k)
+		}
+	}
+
+	if len(keys) == 0 {
+		return ""
+	}
+
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString("/*")
+
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		b.WriteString(escapeComment(k))
+		b.WriteString("='")
+		b.WriteString(escapeComment(tags[k]))
+		b.WriteByte('\'')
+	}
+
+	b.WriteString("*/")
+
+	return b.String()
+}
+
+// escapeComment URL-encodes s, so it can't close the comment or the quotes.
+func escapeComment(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCommenterApply(t *testing.T) {
+	ctx := ContextWithComment(context.Background(), "route", "/users", "app", "ctx")
+
+	tests := []struct {
+		name      string
+		commenter Commenter
+		ctx       context.Context
+		op        Op
+		query     string
+		want      string
+	}{
+		{"disabled", Commenter{Ops: OpExec, Tags: map[string]string{"app": "api"}},
+			context.Background(), OpQuery, "SELECT 1", "SELECT 1"},
+		{"static", Commenter{Ops: OpQuery, Tags: map[string]string{"app": "api", "db": "main"}},
+			context.Background(), OpQuery, "SELECT 1", "SELECT 1 /*app='api',db='main'*/"},
+		{"semicolon", Commenter{Ops: OpQuery, Tags: map[string]string{"app": "api"}},
+			context.Background(), OpQuery, "SELECT 1; \n", "SELECT 1 /*app='api'*/;"},
+		{"commented", Commenter{Ops: OpQuery, Tags: map[string]string{"app": "api"}},
+			context.Background(), OpQuery, "SELECT /*+ INDEX(users) */ 1", "SELECT /*+ INDEX(users) */ 1"},
+		{"empty", Commenter{Ops: OpQuery, Tags: map[string]string{"app": "", "": "api"}},
+			context.Background(), OpQuery, "SELECT 1", "SELECT 1"},
+		{"override", Commenter{
+			Ops:  OpQuery,
+			Tags: map[string]string{"app": "api", "db": "main", "trace": "static"},
+			Func: func(context.Context) map[string]string {
+				return map[string]string{"app": "func", "trace": "func"}
+			},
+		}, ctx, OpQuery, "SELECT 1", "SELECT 1 /*app='ctx',db='main',route='%2Fusers',trace='func'*/"},
+		{"escape", Commenter{Ops: OpQuery, Tags: map[string]string{"a b": "*/ DROP TABLE users; --", "q": "it's"}},
+			context.Background(), OpQuery, "SELECT 1",
+			"SELECT 1 /*a%20b='%2A%2F%20DROP%20TABLE%20users%3B%20--',q='it%27s'*/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.commenter.Apply(tt.ctx, tt.op, tt.query); got != tt.want {
+				t.Errorf("Apply() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContextWithComment(t *testing.T) {
+	parent := ContextWithComment(context.Background(), "route", "/users", "odd")
+	ctx := ContextWithComment(parent, "route", "/orders", "controller", "orders")
+
+	c := Commenter{Ops: OpExec}
+
+	if got, want := c.Apply(parent, OpExec, "DELETE FROM users"),
+		"DELETE FROM users /*route='%2Fusers'*/"; got != want {
+		t.Errorf("Apply() with parent = %q, want %q", got, want)
+	}
+
+	if got, want := c.Apply(ctx, OpExec, "DELETE FROM users"),
+		"DELETE FROM users /*controller='orders',route='%2Forders'*/"; got != want {
+		t.Errorf("Apply() = %q, want %q", got, want)
+	}
+}
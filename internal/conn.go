@@ -1,41 +1,66 @@
 package internal
 
+//go:generate go run gen.go
+
 import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
 	"errors"
+	"log/slog"
 	"time"
-
-	"golang.org/x/exp/slog"
 )
 
 type Conn struct {
 	conn    driver.Conn
+	id      string // connection identifier
 	started time.Time
 	logger  Logger
+	tx      *Tx // the transaction in progress
 }
 
-// NewConn returns a new wrapped Conn.
-func NewConn(conn driver.Conn, logger Logger) *Conn {
-	return &Conn{
+// NewConn returns a new wrapped Conn with the identifier. It implements
+// the same optional interfaces as the driver connection.
+func NewConn(conn driver.Conn, id string, logger Logger) driver.Conn {
+	c := &Conn{
 		conn:    conn,
+		id:      id,
 		started: time.Now(),
 		logger:  logger,
 	}
+	c.logger.connID = id
+	c.logger.conn = c
+
+	return wrapConn(c)
 }
 
 var (
-	_ driver.Pinger             = (*Conn)(nil)
-	_ driver.Execer             = (*Conn)(nil) //nolint:staticcheck // implemented
-	_ driver.ExecerContext      = (*Conn)(nil)
-	_ driver.Queryer            = (*Conn)(nil) //nolint:staticcheck // implemented
-	_ driver.QueryerContext     = (*Conn)(nil)
 	_ driver.Conn               = (*Conn)(nil)
 	_ driver.ConnPrepareContext = (*Conn)(nil)
 	_ driver.ConnBeginTx        = (*Conn)(nil)
-	_ driver.SessionResetter    = (*Conn)(nil)
-	_ driver.NamedValueChecker  = (*Conn)(nil)
+)
+
+// The optional interfaces of the driver connection. The Conn is combined
+// with them by wrapConn only if its driver connection implements them,
+// so the database/sql package handles the Conn as the driver connection.
+// The driver.Execer and driver.Queryer are implemented as the context
+// versions, as the database/sql package uses them the same way.
+type (
+	pinger            struct{ *Conn }
+	execer            struct{ *Conn }
+	queryer           struct{ *Conn }
+	sessionResetter   struct{ *Conn }
+	namedValueChecker struct{ *Conn }
+	validator         struct{ *Conn }
+)
+
+var (
+	_ driver.Pinger            = pinger{}
+	_ driver.ExecerContext     = execer{}
+	_ driver.QueryerContext    = queryer{}
+	_ driver.SessionResetter   = sessionResetter{}
+	_ driver.NamedValueChecker = namedValueChecker{}
+	_ driver.Validator         = validator{}
 )
 
 // Pinger is an optional interface that may be implemented by a Conn.
@@ -45,38 +70,24 @@ var (
 //
 // If Conn.Ping returns ErrBadConn, DB.Ping and DB.PingContext will remove
 // the Conn from pool.
-func (c *Conn) Ping(ctx context.Context) (err error) {
-	defer func(started time.Time) {
-		c.logger.Log(ctx, slog.LevelDebug, "ping", started, err)
-	}(time.Now())
+func (c pinger) Ping(ctx context.Context) (err error) {
+	c.setContextIDs(ctx)
 
-	if pinger, ok := c.conn.(driver.Pinger); !ok {
-		return pinger.Ping(ctx)
-	}
+	ctx, cancel, timeout := c.logger.withTimeout(ctx, OpPing)
+	defer cancel()
 
-	return nil // driver doesn't implement, nothing to do
-}
+	var attempt int
 
-// Execer is an optional interface that may be implemented by a Conn.
-//
-// If a Conn implements neither ExecerContext nor Execer,
-// the sql package's DB.Exec will first prepare a query, execute the statement,
-// and then close the statement.
-//
-// Exec may return ErrSkip.
-//
-// Deprecated: Drivers should implement ExecerContext instead.
-func (c *Conn) Exec(query string, args []driver.Value) (_ driver.Result, err error) {
 	defer func(started time.Time) {
-		c.logger.Log(context.Background(), slog.LevelInfo, "exec", started, err,
-			logQuery(query), logArgs(args))
+		c.logger.Log(ctx, slog.LevelDebug, "ping", started, err,
+			logTimeout(timeout, err), logAttempt(attempt))
 	}(time.Now())
 
-	if execer, ok := c.conn.(driver.Execer); !ok {
-		return execer.Exec(query, args)
-	}
+	attempt, err = c.logger.retry(ctx, OpPing, "ping", func() error {
+		return c.conn.(driver.Pinger).Ping(ctx)
+	})
 
-	return nil, driver.ErrSkip
+	return err
 }
 
 // ExecerContext is an optional interface that may be implemented by a Conn.
@@ -89,36 +100,39 @@ func (c *Conn) Exec(query string, args []driver.Value) (_ driver.Result, err err
 // ExecContext may return ErrSkip.
 //
 // ExecContext must honor the context timeout and return when the context is canceled.
-func (c *Conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (_ driver.Result, err error) {
+func (c execer) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (res driver.Result, err error) {
+	c.setContextIDs(ctx)
+
+	ctx, cancel, timeout := c.logger.withTimeout(ctx, OpExec)
+	defer cancel()
+
 	defer func(started time.Time) {
-		c.logger.Log(ctx, slog.LevelInfo, "execContext", started, err,
-			logQuery(query), logArgs(args))
+		c.recordExec(nil, query, args, res, err)
+
+		if c.logger.Enabled(ctx, slog.LevelInfo, err) {
+			c.logger.Log(ctx, slog.LevelInfo, "execContext", started, err,
+				logQuery(query), c.logger.logArgs(args), logTimeout(timeout, err))
+		}
 	}(time.Now())
 
-	if execer, ok := c.conn.(driver.ExecerContext); !ok {
-		return execer.ExecContext(ctx, query, args)
-	}
+	commented := c.logger.Comment.Apply(ctx, OpExec, query)
 
-	return nil, driver.ErrSkip
-}
+	switch execer := c.conn.(type) {
+	case driver.ExecerContext:
+		return execer.ExecContext(ctx, commented, args)
+	case driver.Execer: //nolint:staticcheck // fallback
+		dargs, err := namedValueToValue(args)
+		if err != nil {
+			return nil, err
+		}
 
-// Queryer is an optional interface that may be implemented by a Conn.
-//
-// If a Conn implements neither QueryerContext nor Queryer,
-// the sql package's DB.Query will first prepare a query, execute the statement,
-// and then close the statement.
-//
-// Query may return ErrSkip.
-//
-// Deprecated: Drivers should implement QueryerContext instead.
-func (c *Conn) Query(query string, args []driver.Value) (_ driver.Rows, err error) {
-	defer func(started time.Time) {
-		c.logger.Log(context.Background(), slog.LevelInfo, "query", started, err,
-			logQuery(query), logArgs(args))
-	}(time.Now())
+		select {
+		default:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
 
-	if queryer, ok := c.conn.(driver.Queryer); ok {
-		return queryer.Query(query, args)
+		return execer.Exec(commented, dargs)
 	}
 
 	return nil, driver.ErrSkip
@@ -134,14 +148,61 @@ func (c *Conn) Query(query string, args []driver.Value) (_ driver.Rows, err erro
 // QueryContext may return ErrSkip.
 //
 // QueryContext must honor the context timeout and return when the context is canceled.
-func (c *Conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (_ driver.Rows, err error) {
+func (c queryer) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (_ driver.Rows, err error) {
+	c.setContextIDs(ctx)
+
+	ctx, cancel, timeout := c.logger.withTimeout(ctx, OpQuery)
+
+	var attempt int
+
 	defer func(started time.Time) {
-		c.logger.Log(ctx, slog.LevelInfo, "queryContext", started, err,
-			logQuery(query), logArgs(args))
+		if c.logger.Enabled(ctx, slog.LevelInfo, err) {
+			c.logger.Log(ctx, slog.LevelInfo, "queryContext", started, err,
+				logQuery(query), c.logger.logArgs(args), logTimeout(timeout, err), logAttempt(attempt))
+		}
 	}(time.Now())
 
-	if queryer, ok := c.conn.(driver.QueryerContext); ok {
+	var rows driver.Rows
+
+	commented := c.logger.Comment.Apply(ctx, OpQuery, query)
+	fn := func() (err error) {
+		rows, err = c.queryContext(ctx, commented, args)
+		return err
+	}
+
+	// only idempotent queries outside transactions are retried
+	if c.logger.Retry.Ops.Has(OpQuery) && c.tx == nil && isRetryable(ctx) {
+		attempt, err = c.logger.retry(ctx, OpQuery, "queryContext", fn)
+	} else {
+		err = fn()
+	}
+
+	if timeout == 0 {
+		cancel = nil
+	}
+
+	return c.logger.wrapRows(ctx, rows, err, cancel,
+		c.recordQuery(nil, query, args, err))
+}
+
+// queryContext queries the driver connection, falling back to the Queryer.
+func (c queryer) queryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	switch queryer := c.conn.(type) {
+	case driver.QueryerContext:
 		return queryer.QueryContext(ctx, query, args)
+	case driver.Queryer: //nolint:staticcheck // fallback
+		dargs, err := namedValueToValue(args)
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		default:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		return queryer.Query(query, dargs)
 	}
 
 	return nil, driver.ErrSkip
@@ -149,11 +210,15 @@ func (c *Conn) QueryContext(ctx context.Context, query string, args []driver.Nam
 
 // Prepare returns a prepared statement, bound to this connection.
 func (c *Conn) Prepare(query string) (_ driver.Stmt, err error) {
-	stmtID := slog.String("stmtID", NewUID())
+	var s *Stmt
 
 	defer func(started time.Time) {
-		c.logger.Log(context.Background(), slog.LevelInfo, "prepare", started, err,
-			stmtID, logQuery(query))
+		c.record(EventPrepare, s, query, nil, err)
+
+		if c.logger.Enabled(context.Background(), slog.LevelInfo, err) {
+			c.logger.Log(context.Background(), slog.LevelInfo, "prepare", started, err,
+				s.logID(c.logger.idGenerator(context.Background())), logQuery(query))
+		}
 	}(time.Now())
 
 	stmt, err := c.conn.Prepare(query)
@@ -161,28 +226,43 @@ func (c *Conn) Prepare(query string) (_ driver.Stmt, err error) {
 		return nil, err
 	}
 
-	return c.newStmt(stmt, query, stmtID), nil
+	s = c.newStmt(context.Background(), stmt, query)
+
+	return wrapStmt(s), nil
 }
 
 // ConnPrepareContext enhances the Conn interface with context.
 func (c *Conn) PrepareContext(ctx context.Context, query string) (_ driver.Stmt, err error) {
-	stmtID := slog.String("stmtID", NewUID())
+	c.setContextIDs(ctx)
+
+	var s *Stmt
+
+	ctx, cancel, timeout := c.logger.withTimeout(ctx, OpPrepare)
+	defer cancel()
 
 	defer func(started time.Time) {
-		c.logger.Log(ctx, slog.LevelInfo, "prepareContext", started, err,
-			stmtID, logQuery(query))
+		c.record(EventPrepare, s, query, nil, err)
+
+		if c.logger.Enabled(ctx, slog.LevelInfo, err) {
+			c.logger.Log(ctx, slog.LevelInfo, "prepareContext", started, err,
+				s.logID(c.logger.idGenerator(ctx)), logQuery(query), logTimeout(timeout, err))
+		}
 	}(time.Now())
 
+	commented := c.logger.Comment.Apply(ctx, OpPrepare, query)
+
 	if prepare, ok := c.conn.(driver.ConnPrepareContext); ok {
-		stmt, err := prepare.PrepareContext(ctx, query)
+		stmt, err := prepare.PrepareContext(ctx, commented)
 		if err != nil {
 			return nil, err
 		}
 
-		return c.newStmt(stmt, query, stmtID), nil
+		s = c.newStmt(ctx, stmt, query)
+
+		return wrapStmt(s), nil
 	}
 
-	stmt, err := c.conn.Prepare(query)
+	stmt, err := c.conn.Prepare(commented)
 	if err != nil {
 		return nil, err
 	}
@@ -194,18 +274,24 @@ func (c *Conn) PrepareContext(ctx context.Context, query string) (_ driver.Stmt,
 		return nil, ctx.Err()
 	}
 
-	return c.newStmt(stmt, query, stmtID), nil
+	s = c.newStmt(ctx, stmt, query)
+
+	return wrapStmt(s), nil
 }
 
 // Begin starts and returns a new transaction.
 //
 // Deprecated: Drivers should implement ConnBeginTx instead (or additionally).
 func (c *Conn) Begin() (_ driver.Tx, err error) {
-	txID := slog.String("txID", NewUID())
+	var t *Tx
 
 	defer func(started time.Time) {
-		c.logger.Log(context.Background(), slog.LevelInfo, "begin", started, err,
-			txID)
+		c.record(EventBegin, nil, "", nil, err)
+
+		if c.logger.Enabled(context.Background(), slog.LevelInfo, err) {
+			c.logger.Log(context.Background(), slog.LevelInfo, "begin", started, err,
+				t.logID(c.logger.idGenerator(context.Background())))
+		}
 	}(time.Time{})
 
 	tx, err := c.conn.Begin()
@@ -213,7 +299,9 @@ func (c *Conn) Begin() (_ driver.Tx, err error) {
 		return nil, err
 	}
 
-	return c.newTx(tx, txID), nil
+	t = c.newTx(context.Background(), tx)
+
+	return t, nil
 }
 
 // BeginTx starts and returns a new transaction.
@@ -229,11 +317,16 @@ func (c *Conn) Begin() (_ driver.Tx, err error) {
 // value is true to either set the read-only transaction property if supported
 // or return an error if it is not supported.
 func (c *Conn) BeginTx(ctx context.Context, opts driver.TxOptions) (_ driver.Tx, err error) {
-	txID := slog.String("txID", NewUID())
+	var t *Tx
 
 	defer func(started time.Time) {
-		c.logger.Log(ctx, slog.LevelInfo, "beginTx", started, err,
-			txID, slog.Bool("readOnly", opts.ReadOnly))
+		c.setContextIDs(ctx) // with the started transaction
+		c.record(EventBegin, nil, "", nil, err)
+
+		if c.logger.Enabled(ctx, slog.LevelInfo, err) {
+			c.logger.Log(ctx, slog.LevelInfo, "beginTx", started, err,
+				t.logID(c.logger.idGenerator(ctx)), slog.Bool("readOnly", opts.ReadOnly))
+		}
 	}(time.Time{})
 
 	if conn, ok := c.conn.(driver.ConnBeginTx); ok {
@@ -242,7 +335,9 @@ func (c *Conn) BeginTx(ctx context.Context, opts driver.TxOptions) (_ driver.Tx,
 			return nil, err
 		}
 
-		return c.newTx(tx, txID), nil
+		t = c.newTx(ctx, tx)
+
+		return t, nil
 	}
 
 	// Code borrowed from ctxutil.go in the go standard library.
@@ -272,43 +367,118 @@ func (c *Conn) BeginTx(ctx context.Context, opts driver.TxOptions) (_ driver.Tx,
 		}
 	}
 
-	return c.newTx(tx, txID), nil
+	t = c.newTx(ctx, tx)
+
+	return t, nil
 }
 
 // SessionResetter may be implemented by Conn to allow drivers to reset the
 // session state associated with the connection and to signal a bad connection.
-func (c *Conn) ResetSession(ctx context.Context) (err error) {
+func (c sessionResetter) ResetSession(ctx context.Context) (err error) {
+	c.setContextIDs(ctx)
+
 	defer func(started time.Time) {
 		c.logger.Log(ctx, slog.LevelDebug, "resetSession", started, err)
 	}(time.Time{})
 
-	if resetSessin, ok := c.conn.(driver.SessionResetter); ok {
-		return resetSessin.ResetSession(ctx)
-	}
+	return c.conn.(driver.SessionResetter).ResetSession(ctx)
+}
 
-	return nil // driver does not implement, there is nothing to do.
+// NamedValueChecker may be optionally implemented by Conn or Stmt.
+// It provides the driver more control to handle Go and database types.
+func (c namedValueChecker) CheckNamedValue(namedValue *driver.NamedValue) error {
+	return c.conn.(driver.NamedValueChecker).CheckNamedValue(namedValue)
 }
 
-func (c *Conn) CheckNamedValue(namedValue *driver.NamedValue) (err error) {
-	if namedValueChecker, ok := c.conn.(driver.NamedValueChecker); ok {
-		return namedValueChecker.CheckNamedValue(namedValue)
-	}
+// Validator may be implemented by Conn to allow drivers to signal
+// if a connection is valid or if it should be discarded.
+//
+// The invalid connection, discarded by the pool, is logged as warning.
+func (c validator) IsValid() (valid bool) {
+	defer func() {
+		level := slog.LevelDebug
+		if !valid {
+			level = slog.LevelWarn
+		}
 
-	return driver.ErrSkip
+		c.logger.Log(context.Background(), level, "isValid", time.Time{}, nil,
+			slog.Bool("valid", valid), slog.Duration("age", time.Since(c.started)))
+	}()
+
+	return c.conn.(driver.Validator).IsValid()
 }
 
 func (c *Conn) Close() (err error) {
 	defer func() {
+		c.record(EventClose, nil, "", nil, err)
 		c.logger.Log(context.Background(), slog.LevelInfo, "close", c.started, err)
 	}()
 
 	return c.conn.Close()
 }
 
-func (c *Conn) newTx(tx driver.Tx, id slog.Attr) *Tx {
-	return NewTx(tx, c.logger.With(id))
+// Unwrap returns the driver connection.
+func (c *Conn) Unwrap() driver.Conn {
+	return c.conn
+}
+
+func (c *Conn) newTx(ctx context.Context, tx driver.Tx) *Tx {
+	t := NewTx(tx, c.logger)
+	t.id.newID = c.logger.idGenerator(ctx)
+	t.ctx = logContext(ctx)
+	t.conn = c
+	c.tx = t
+
+	return t
+}
+
+func (c *Conn) newStmt(ctx context.Context, stmt driver.Stmt, query string) *Stmt {
+	s := NewStmt(stmt, query, c.logger)
+	s.id.newID = c.logger.idGenerator(ctx)
+	s.ctx = logContext(ctx)
+	s.conn = c
+
+	return s
 }
 
-func (c *Conn) newStmt(stmt driver.Stmt, query string, id slog.Attr) *Stmt {
-	return NewStmt(stmt, query, c.logger.With(id))
+// event returns the recorded event of the operation on the connection,
+// its transaction and the statement, if any.
+func (c *Conn) event(op string, s *Stmt, query string) Event {
+	e := Event{Op: op, ConnID: c.id, Query: query}
+	if c.tx != nil {
+		e.TxID = c.tx.id.get()
+	}
+
+	if s != nil {
+		e.StmtID = s.id.get()
+	}
+
+	return e
+}
+
+// record records the operation, if the recording is enabled.
+func (c *Conn) record(op string, s *Stmt, query string, args any, err error) {
+	if c == nil || c.logger.Recorder == nil {
+		return
+	}
+
+	c.logger.Recorder.Record(c.event(op, s, query), args, err)
+}
+
+// recordExec records the executed query with its result.
+func (c *Conn) recordExec(s *Stmt, query string, args any, res driver.Result, err error) {
+	if c == nil || c.logger.Recorder == nil {
+		return
+	}
+
+	c.logger.Recorder.RecordExec(c.event(EventExec, s, query), args, res, err)
+}
+
+// recordQuery records the query and returns the recorder of its rows.
+func (c *Conn) recordQuery(s *Stmt, query string, args any, err error) *rowsRecord {
+	if c == nil || c.logger.Recorder == nil {
+		return nil
+	}
+
+	return c.logger.Recorder.RecordQuery(c.event(EventQuery, s, query), args, err)
 }
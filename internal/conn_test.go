@@ -0,0 +1,402 @@
+package internal
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/mdigger/sqlog/sqlogtest/fakedriver"
+)
+
+var errInjected = errors.New("injected")
+
+// injectErr returns the injected error of the call.
+func injectErr(call string, err error) func(string, string) error {
+	return func(c, _ string) error {
+		if c == call {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func TestConnExecContext(t *testing.T) {
+	tests := []struct {
+		name       string
+		interfaces fakedriver.Interface
+		calls      []string
+	}{
+		{"context", fakedriver.ExecerContext, []string{"Conn.ExecContext"}},
+		{"legacy", fakedriver.Legacy, []string{"Conn.Exec"}},
+		{"both", fakedriver.Legacy | fakedriver.ExecerContext, []string{"Conn.ExecContext"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, d, h := openConn(t, &fakedriver.Options{
+				Interfaces: tt.interfaces,
+				Result: func(string, []driver.NamedValue) driver.Result {
+					return driver.RowsAffected(3)
+				},
+			}, nil)
+
+			res, err := conn.(driver.ExecerContext).ExecContext(context.Background(),
+				"DELETE FROM users WHERE id = ?", []driver.NamedValue{{Ordinal: 1, Value: int64(1)}})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if n, _ := res.RowsAffected(); n != 3 {
+				t.Errorf("rows affected = %d, want 3", n)
+			}
+
+			if calls := d.Calls(); !slices.Equal(calls, tt.calls) {
+				t.Errorf("calls = %q, want %q", calls, tt.calls)
+			}
+
+			r, attrs := h.Last(t, "execContext")
+			if r.Level != slog.LevelInfo || attrs["query"].String() != "DELETE FROM users WHERE id = ?" {
+				t.Errorf("unexpected record %v", r)
+			}
+		})
+	}
+}
+
+func TestConnExecError(t *testing.T) {
+	tests := []struct {
+		interfaces fakedriver.Interface
+		call       string
+	}{
+		{fakedriver.ExecerContext, "Conn.ExecContext"},
+		{fakedriver.Legacy, "Conn.Exec"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.call, func(t *testing.T) {
+			conn, _, h := openConn(t, &fakedriver.Options{
+				Interfaces: tt.interfaces,
+				Err:        injectErr(tt.call, errInjected),
+			}, nil)
+
+			_, err := conn.(driver.ExecerContext).ExecContext(context.Background(), "DELETE FROM users", nil)
+			if !errors.Is(err, errInjected) {
+				t.Fatalf("error = %v, want %v", err, errInjected)
+			}
+
+			r, attrs := h.Last(t, "execContext")
+			if r.Level != slog.LevelError || attrs["error"].Any() != errInjected {
+				t.Errorf("unexpected record %v", r)
+			}
+		})
+	}
+}
+
+func TestConnExecTimeout(t *testing.T) {
+	conn, _, h := openConn(t, &fakedriver.Options{
+		Interfaces: fakedriver.ExecerContext,
+		Delay:      200 * time.Millisecond,
+	}, &Config{DefaultTimeout: 10 * time.Millisecond})
+
+	started := time.Now()
+
+	_, err := conn.(driver.ExecerContext).ExecContext(context.Background(), "SELECT pg_sleep(0.2)", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if elapsed := time.Since(started); elapsed >= 200*time.Millisecond {
+		t.Errorf("the timeout is not applied: %v", elapsed)
+	}
+
+	if _, attrs := h.Last(t, "execContext"); attrs["timeout"].Duration() != 10*time.Millisecond {
+		t.Errorf("timeout = %v, want %v", attrs["timeout"], 10*time.Millisecond)
+	}
+}
+
+func TestConnExecCanceled(t *testing.T) {
+	conn, d, _ := openConn(t, &fakedriver.Options{Interfaces: fakedriver.Legacy}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// the legacy Execer has no context, so it is checked before the call
+	_, err := conn.(driver.ExecerContext).ExecContext(ctx, "DELETE FROM users", nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("error = %v, want %v", err, context.Canceled)
+	}
+
+	if calls := d.Calls(); len(calls) != 0 {
+		t.Errorf("calls = %q, want none", calls)
+	}
+}
+
+func TestConnWithoutExecer(t *testing.T) {
+	conn, _, _ := openConn(t, &fakedriver.Options{Interfaces: fakedriver.ConnPrepareContext}, nil)
+
+	if _, ok := conn.(driver.ExecerContext); ok {
+		t.Error("the connection implements driver.ExecerContext without the driver one")
+	}
+
+	if _, ok := conn.(driver.QueryerContext); ok {
+		t.Error("the connection implements driver.QueryerContext without the driver one")
+	}
+}
+
+func TestConnQueryContext(t *testing.T) {
+	tests := []struct {
+		name       string
+		interfaces fakedriver.Interface
+		calls      []string
+	}{
+		{"context", fakedriver.QueryerContext, []string{"Conn.QueryContext"}},
+		{"legacy", fakedriver.Legacy, []string{"Conn.Query"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, d, h := openConn(t, &fakedriver.Options{
+				Interfaces: tt.interfaces,
+				Rows: func(string, []driver.NamedValue) ([]string, [][]driver.Value) {
+					return []string{"id"}, [][]driver.Value{{int64(1)}, {int64(2)}}
+				},
+			}, nil)
+
+			rows, err := conn.(driver.QueryerContext).QueryContext(context.Background(), "SELECT id FROM users", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var n int
+
+			dest := make([]driver.Value, 1)
+			for rows.Next(dest) == nil {
+				n++
+			}
+
+			if err := rows.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			if n != 2 {
+				t.Errorf("read %d rows, want 2", n)
+			}
+
+			if calls := d.Calls(); !slices.Equal(calls, tt.calls) {
+				t.Errorf("calls = %q, want %q", calls, tt.calls)
+			}
+
+			h.Last(t, "queryContext")
+		})
+	}
+}
+
+func TestConnQueryTimeout(t *testing.T) {
+	conn, _, h := openConn(t, &fakedriver.Options{
+		Interfaces: fakedriver.QueryerContext,
+		Delay:      200 * time.Millisecond,
+	}, &Config{Timeouts: map[Op]time.Duration{OpQuery: 10 * time.Millisecond}})
+
+	_, err := conn.(driver.QueryerContext).QueryContext(context.Background(), "SELECT 1", nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if _, attrs := h.Last(t, "queryContext"); attrs["timeout"].Duration() != 10*time.Millisecond {
+		t.Errorf("timeout = %v, want %v", attrs["timeout"], 10*time.Millisecond)
+	}
+}
+
+func TestConnQueryRetry(t *testing.T) {
+	deadlock := sqlStateError("40P01")
+
+	tests := []struct {
+		name  string
+		err   error
+		ctx   context.Context
+		calls int
+	}{
+		{"deadlock", deadlock, ContextWithRetry(context.Background()), 3},
+		{"badConn", driver.ErrBadConn, ContextWithRetry(context.Background()), 1},
+		{"notIdempotent", deadlock, context.Background(), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, d, h := openConn(t, &fakedriver.Options{
+				Interfaces: fakedriver.QueryerContext,
+				Err:        injectErr("Conn.QueryContext", tt.err),
+			}, &Config{
+				Classifiers: DefaultClassifiers,
+				Retry:       RetryPolicy{Ops: OpQuery, MaxAttempts: 3},
+			})
+
+			_, err := conn.(driver.QueryerContext).QueryContext(tt.ctx, "SELECT 1", nil)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("error = %v, want %v", err, tt.err)
+			}
+
+			if calls := len(d.Calls()); calls != tt.calls {
+				t.Errorf("%d calls, want %d", calls, tt.calls)
+			}
+
+			if tt.calls > 1 {
+				if _, attrs := h.Last(t, "queryContext"); attrs["attempt"].Int64() != int64(tt.calls) {
+					t.Errorf("attempt = %v, want %d", attrs["attempt"], tt.calls)
+				}
+			}
+		})
+	}
+}
+
+func TestConnPing(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		calls int
+	}{
+		{"ok", nil, 1},
+		{"serialization", sqlStateError("40001"), 2},
+		{"badConn", driver.ErrBadConn, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, d, h := openConn(t, &fakedriver.Options{
+				Interfaces: fakedriver.Pinger,
+				Err:        injectErr("Conn.Ping", tt.err),
+			}, &Config{
+				Classifiers: DefaultClassifiers,
+				Retry:       RetryPolicy{Ops: OpPing, MaxAttempts: 2},
+			})
+
+			if err := conn.(driver.Pinger).Ping(context.Background()); !errors.Is(err, tt.err) {
+				t.Fatalf("error = %v, want %v", err, tt.err)
+			}
+
+			if calls := len(d.Calls()); calls != tt.calls {
+				t.Errorf("%d calls, want %d", calls, tt.calls)
+			}
+
+			h.Last(t, "ping")
+		})
+	}
+}
+
+func TestConnPrepare(t *testing.T) {
+	tests := []struct {
+		name       string
+		interfaces fakedriver.Interface
+		prepare    func(driver.Conn) (driver.Stmt, error)
+		call       string
+		msg        string
+	}{
+		{"context", fakedriver.ConnPrepareContext, func(c driver.Conn) (driver.Stmt, error) {
+			return c.(driver.ConnPrepareContext).PrepareContext(context.Background(), "SELECT 1")
+		}, "Conn.PrepareContext", "prepareContext"},
+		{"contextFallback", 0, func(c driver.Conn) (driver.Stmt, error) {
+			return c.(driver.ConnPrepareContext).PrepareContext(context.Background(), "SELECT 1")
+		}, "Conn.Prepare", "prepareContext"},
+		{"legacy", fakedriver.ConnPrepareContext, func(c driver.Conn) (driver.Stmt, error) {
+			return c.Prepare("SELECT 1")
+		}, "Conn.Prepare", "prepare"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, d, h := openConn(t, &fakedriver.Options{Interfaces: tt.interfaces}, nil)
+
+			stmt, err := tt.prepare(conn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if calls := d.Calls(); !slices.Equal(calls, []string{tt.call}) {
+				t.Errorf("calls = %q, want %q", calls, tt.call)
+			}
+
+			if _, attrs := h.Last(t, tt.msg); attrs[stmtIDKey].String() != stmt.(*Stmt).id.get() {
+				t.Errorf("stmtID = %v, want %v", attrs[stmtIDKey], stmt.(*Stmt).id.get())
+			}
+		})
+	}
+}
+
+func TestConnPrepareError(t *testing.T) {
+	conn, _, h := openConn(t, &fakedriver.Options{
+		Interfaces: fakedriver.ConnPrepareContext,
+		Err:        injectErr("Conn.PrepareContext", errInjected),
+	}, nil)
+
+	stmt, err := conn.(driver.ConnPrepareContext).PrepareContext(context.Background(), "SELECT 1")
+	if !errors.Is(err, errInjected) || stmt != nil {
+		t.Fatalf("prepare = %v, %v, want the error %v", stmt, err, errInjected)
+	}
+
+	// the failed statement has the own identifier in the log
+	if _, attrs := h.Last(t, "prepareContext"); attrs[stmtIDKey].String() == "" {
+		t.Error("no stmtID of the failed statement")
+	}
+}
+
+func TestConnPrepareTimeout(t *testing.T) {
+	conn, _, _ := openConn(t, &fakedriver.Options{
+		Interfaces: fakedriver.ConnPrepareContext,
+		Delay:      200 * time.Millisecond,
+	}, &Config{DefaultTimeout: 10 * time.Millisecond})
+
+	_, err := conn.(driver.ConnPrepareContext).PrepareContext(context.Background(), "SELECT 1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestConnResetSession(t *testing.T) {
+	conn, _, h := openConn(t, &fakedriver.Options{
+		Interfaces: fakedriver.SessionResetter,
+		Err:        injectErr("Conn.ResetSession", driver.ErrBadConn),
+	}, nil)
+
+	err := conn.(driver.SessionResetter).ResetSession(context.Background())
+	if !errors.Is(err, driver.ErrBadConn) {
+		t.Fatalf("error = %v, want %v", err, driver.ErrBadConn)
+	}
+
+	h.Last(t, "resetSession")
+}
+
+func TestConnIsValid(t *testing.T) {
+	conn, _, h := openConn(t, &fakedriver.Options{
+		Interfaces: fakedriver.Validator,
+		Err:        injectErr("Conn.IsValid", io.EOF),
+	}, nil)
+
+	if conn.(driver.Validator).IsValid() {
+		t.Fatal("the connection is valid, want invalid")
+	}
+
+	if r, attrs := h.Last(t, "isValid"); r.Level != slog.LevelWarn || attrs["valid"].Bool() {
+		t.Errorf("unexpected record %v", r)
+	}
+}
+
+func TestConnClose(t *testing.T) {
+	conn, d, h := openConn(t, &fakedriver.Options{Err: injectErr("Conn.Close", errInjected)}, nil)
+
+	if err := conn.Close(); !errors.Is(err, errInjected) {
+		t.Fatalf("error = %v, want %v", err, errInjected)
+	}
+
+	if calls := d.Calls(); !slices.Equal(calls, []string{"Conn.Close"}) {
+		t.Errorf("calls = %q, want Conn.Close", calls)
+	}
+
+	if r, _ := h.Last(t, "close"); r.Level != slog.LevelError {
+		t.Errorf("level = %v, want %v", r.Level, slog.LevelError)
+	}
+}
@@ -3,23 +3,25 @@ package internal
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
+	"log/slog"
 	"time"
-
-	"golang.org/x/exp/slog"
 )
 
 // Connector represents a driver in a fixed configuration.
 type Connector struct {
-	dsn    string
-	driver driver.Driver
-	logger Logger
+	dsn     string
+	driver  driver.Driver
+	logger  Logger
+	breaker *breaker
 }
 
 func NewConnector(dsn string, d driver.Driver, logger Logger) *Connector {
 	return &Connector{
-		dsn:    dsn,
-		driver: d,
-		logger: logger,
+		dsn:     dsn,
+		driver:  d,
+		logger:  logger,
+		breaker: newBreaker(logger.Breaker),
 	}
 }
 
@@ -39,18 +41,71 @@ var _ driver.Connector = (*Connector)(nil)
 // The returned connection is only used by one goroutine at a
 // time.
 func (c *Connector) Connect(ctx context.Context) (_ driver.Conn, err error) {
-	connID := slog.String("connID", NewUID())
+	id := c.logger.newID()
+
+	logger := c.logger.With(slog.String(connIDKey, id))
+	logger.connID = id
+
+	var attempt int
 
 	defer func(started time.Time) {
-		c.logger.Log(ctx, slog.LevelInfo, "connect", started, err, connID)
+		if errors.Is(err, ErrCircuitOpen) {
+			return // only the transitions of the circuit breaker are logged
+		}
+
+		c.logger.Recorder.Record(Event{Op: EventConnect, ConnID: id}, nil, err)
+
+		logger.Log(ctx, slog.LevelInfo, "connect", started, err, logAttempt(attempt))
 	}(time.Now())
 
-	conn, err := c.driver.Open(c.dsn)
+	var conn driver.Conn
+
+	attempt, err = logger.retry(ctx, OpConnect, "connect", func() (err error) {
+		conn, err = c.open(ctx)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return NewConn(conn, c.logger.With(connID)), nil
+	return NewConn(conn, id, logger), nil
+}
+
+// open opens the driver connection through the circuit breaker.
+func (c *Connector) open(ctx context.Context) (driver.Conn, error) {
+	if c.breaker == nil {
+		return c.driver.Open(c.dsn)
+	}
+
+	allowed, changed := c.breaker.allow(time.Now())
+	if changed {
+		c.logBreaker(ctx)
+	}
+
+	if !allowed {
+		return nil, ErrCircuitOpen
+	}
+
+	conn, err := c.driver.Open(c.dsn)
+
+	if c.breaker.done(time.Now(), err) {
+		c.logBreaker(ctx)
+	}
+
+	return conn, err
+}
+
+// logBreaker logs the changed state of the circuit breaker.
+func (c *Connector) logBreaker(ctx context.Context) {
+	state, failures := c.breaker.status()
+
+	level := slog.LevelWarn
+	if state == breakerClosed {
+		level = slog.LevelInfo
+	}
+
+	c.logger.Log(ctx, level, "circuit", time.Time{}, nil,
+		slog.String("state", state.String()), slog.Int("failures", failures))
 }
 
 // Driver returns the underlying Driver of the Connector,
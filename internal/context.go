@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+)
+
+type (
+	ctxLoggerKey struct{}
+	ctxLevelKey  struct{}
+	ctxSilentKey struct{}
+)
+
+// ContextWithLogger returns a copy of ctx that reroutes SQL logging to log.
+func ContextWithLogger(ctx context.Context, log *slog.Logger) context.Context {
+	return context.WithValue(ctx, ctxLoggerKey{}, log)
+}
+
+// ContextWithLevel returns a copy of ctx that overrides the base level of SQL logging.
+func ContextWithLevel(ctx context.Context, level slog.Level) context.Context {
+	return context.WithValue(ctx, ctxLevelKey{}, level)
+}
+
+// Silence returns a copy of ctx that disables SQL logging.
+func Silence(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxSilentKey{}, true)
+}
+
+func loggerFromContext(ctx context.Context) (*slog.Logger, bool) {
+	log, ok := ctx.Value(ctxLoggerKey{}).(*slog.Logger)
+	return log, ok && log != nil
+}
+
+func levelFromContext(ctx context.Context) (slog.Level, bool) {
+	level, ok := ctx.Value(ctxLevelKey{}).(slog.Level)
+	return level, ok
+}
+
+func isSilent(ctx context.Context) bool {
+	silent, _ := ctx.Value(ctxSilentKey{}).(bool)
+	return silent
+}
+
+// logContext returns the context with only the logging overrides of ctx.
+// It logs the later calls without context, such as the commit of
+// the transaction begun with ctx.
+func logContext(ctx context.Context) context.Context {
+	result := context.Background()
+
+	if log, ok := ctx.Value(ctxLoggerKey{}).(*slog.Logger); ok {
+		result = ContextWithLogger(result, log)
+	}
+
+	if level, ok := levelFromContext(ctx); ok {
+		result = ContextWithLevel(result, level)
+	}
+
+	if isSilent(ctx) {
+		result = Silence(result)
+	}
+
+	return result
+}
@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Dedup collapses identical error records within the time window
+// into a single record with the count of repeats.
+type Dedup struct {
+	window  time.Duration
+	mu      sync.Mutex
+	records map[dedupKey]*dedupRecord
+}
+
+type dedupKey struct {
+	msg, query, err string
+}
+
+type dedupRecord struct {
+	handler  slog.Handler
+	record   slog.Record
+	ids      IDs // identifiers of the operation, passed with the context
+	repeated int
+}
+
+// NewDedup returns a new deduplication of error records within the window.
+func NewDedup(window time.Duration) *Dedup {
+	return &Dedup{
+		window:  window,
+		records: make(map[dedupKey]*dedupRecord),
+	}
+}
+
+// repeated reports whether the same record was already logged within
+// the window. The records are the same, if they have the same message,
+// error and query fingerprint, so the queries differing only in literals,
+// comments or whitespace are collapsed. The repeated record is counted
+// and the last one is logged with the repeated attribute when the window
+// closes.
+func (d *Dedup) repeated(handler slog.Handler, r slog.Record, err error, query string, ids IDs) bool {
+	key := dedupKey{msg: r.Message, query: query, err: err.Error()}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if dr, ok := d.records[key]; ok {
+		dr.handler = handler
+		dr.record = resolved(r)
+		dr.ids = ids
+		dr.repeated++
+
+		return true
+	}
+
+	d.records[key] = &dedupRecord{}
+	time.AfterFunc(d.window, func() { d.flush(key) })
+
+	return false
+}
+
+// fingerprint returns the fingerprint of the logged query: the query
+// attribute or the query of the statement.
+func (l Logger) fingerprint(attrs []slog.Attr) string {
+	query := l.query
+
+	for _, attr := range attrs {
+		if attr.Key == "query" {
+			query = attr.Value.String()
+			break
+		}
+	}
+
+	return Fingerprint(query)
+}
+
+// flush logs the repeated record when the window closes.
+func (d *Dedup) flush(key dedupKey) {
+	d.mu.Lock()
+	dr := d.records[key]
+	delete(d.records, key)
+	d.mu.Unlock()
+
+	if dr.repeated == 0 {
+		return
+	}
+
+	dr.record.AddAttrs(slog.Int("repeated", dr.repeated))
+	ctx := contextWithIDs(context.Background(), dr.ids)
+	_ = dr.handler.Handle(ctx, dr.record) //nolint:errcheck // nothing to do with it
+}
+
+// resolved returns the copy of the record with the resolved attributes.
+// The record is logged after the call returns, so its attributes must not
+// refer to the memory of the caller, like the LogValuer of the arguments.
+func resolved(r slog.Record) slog.Record {
+	c := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+
+	r.Attrs(func(attr slog.Attr) bool {
+		c.AddAttrs(resolveAttr(attr))
+		return true
+	})
+
+	return c
+}
+
+func resolveAttr(attr slog.Attr) slog.Attr {
+	attr.Value = attr.Value.Resolve()
+	if attr.Value.Kind() != slog.KindGroup {
+		return attr
+	}
+
+	group := attr.Value.Group()
+	attrs := make([]slog.Attr, len(group))
+
+	for i, a := range group {
+		attrs[i] = resolveAttr(a)
+	}
+
+	return slog.Attr{Key: attr.Key, Value: slog.GroupValue(attrs...)}
+}
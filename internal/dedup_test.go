@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDedupFingerprint(t *testing.T) {
+	h := new(recordHandler)
+	logger := Logger{
+		Handler: h,
+		Config: &Config{
+			Dedup: NewDedup(50 * time.Millisecond),
+			Query: QueryPolicy{MaxLen: 8},
+		},
+	}
+	err := errors.New("failed")
+
+	logger.Log(context.Background(), slog.LevelInfo, "exec", time.Time{}, err,
+		logQuery("UPDATE users SET name = 'a' WHERE id = 1"))
+	logger.Log(context.Background(), slog.LevelInfo, "exec", time.Time{}, err,
+		logQuery("update users  set name = 'b' where id = 2 -- retry"))
+	logger.Log(context.Background(), slog.LevelInfo, "exec", time.Time{}, err,
+		logQuery("UPDATE users SET age = 1 WHERE id = 1"))
+
+	if n := len(h.Records()); n != 2 {
+		t.Fatalf("got %d records before the window closes, want 2", n)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	records := h.Records()
+	if len(records) != 3 {
+		t.Fatalf("got %d records after the window closes, want 3", len(records))
+	}
+
+	var repeated int64
+	records[2].Attrs(func(attr slog.Attr) bool {
+		if attr.Key == "repeated" {
+			repeated = attr.Value.Int64()
+		}
+
+		return true
+	})
+
+	if repeated != 1 {
+		t.Errorf("repeated = %d, want 1", repeated)
+	}
+}
+
+func TestDedupResolved(t *testing.T) {
+	h := new(recordHandler)
+	logger := Logger{
+		Handler: h,
+		Config:  &Config{Dedup: NewDedup(50 * time.Millisecond)},
+		connID:  "conn",
+	}
+	err := errors.New("failed")
+	arg := []byte("original")
+
+	for i := 0; i < 2; i++ {
+		args := []driver.NamedValue{{Ordinal: 1, Value: arg}}
+		logger.Log(context.Background(), slog.LevelInfo, "execContext", time.Time{}, err,
+			logQuery("UPDATE t SET v = ?"), logger.logArgs(args))
+	}
+
+	copy(arg, "mutated!") // the caller reuses its memory after the call
+
+	time.Sleep(100 * time.Millisecond)
+
+	records := h.Records()
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2", len(records))
+	}
+
+	var args string
+	records[1].Attrs(func(attr slog.Attr) bool {
+		if attr.Key == "args" {
+			args = fmt.Sprint(attr.Value.Resolve().Any())
+		}
+
+		return true
+	})
+
+	if !strings.Contains(args, fmt.Sprint([]byte("original"))) {
+		t.Errorf("args of the repeated record = %s, want the original ones", args)
+	}
+
+	if ids := h.IDs()[1]; ids.ConnID != "conn" {
+		t.Errorf("identifiers of the repeated record = %+v, want connID", ids)
+	}
+}
@@ -2,8 +2,7 @@ package internal
 
 import (
 	"database/sql/driver"
-
-	"golang.org/x/exp/slog"
+	"log/slog"
 )
 
 // Driver is the interface that must be implemented by a database.
@@ -41,13 +40,16 @@ var (
 // The returned connection is only used by one goroutine at a
 // time.
 func (d *Driver) Open(name string) (driver.Conn, error) {
+	id := d.logger.newID()
+
 	conn, err := d.driver.Open(name)
+	d.logger.Recorder.Record(Event{Op: EventConnect, ConnID: id}, nil, err)
+
 	if err != nil {
 		return nil, err
 	}
 
-	connID := slog.String("connID", NewUID())
-	return NewConn(conn, d.logger.With(connID)), nil
+	return NewConn(conn, id, d.logger.With(slog.String(connIDKey, id))), nil
 }
 
 // If a Driver implements DriverContext, then sql.DB will call OpenConnector
@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"log/slog"
+	"strings"
+)
+
+// ErrorClass is a category of database errors.
+type ErrorClass string
+
+// Known error classes.
+const (
+	ErrorClassConstraint    ErrorClass = "constraint_violation"
+	ErrorClassDeadlock      ErrorClass = "deadlock"
+	ErrorClassSerialization ErrorClass = "serialization_failure"
+	ErrorClassConnection    ErrorClass = "connection_lost"
+	ErrorClassTimeout       ErrorClass = "timeout"
+	ErrorClassCanceled      ErrorClass = "canceled"
+	ErrorClassSyntax        ErrorClass = "syntax"
+)
+
+// Classifier maps an error to its class and driver-specific code.
+// It returns false if the error is unknown to it.
+type Classifier func(err error) (class ErrorClass, code string, ok bool)
+
+// SQLStater is implemented by driver errors with SQLSTATE codes.
+type SQLStater interface {
+	SQLState() string
+}
+
+// ClassifyContext classifies context cancellation and deadline errors.
+func ClassifyContext(err error) (ErrorClass, string, bool) {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorClassTimeout, "", true
+	case errors.Is(err, context.Canceled):
+		return ErrorClassCanceled, "", true
+	default:
+		return "", "", false
+	}
+}
+
+// ClassifyBadConn classifies driver.ErrBadConn as a lost connection.
+func ClassifyBadConn(err error) (ErrorClass, string, bool) {
+	if errors.Is(err, driver.ErrBadConn) {
+		return ErrorClassConnection, "", true
+	}
+
+	return "", "", false
+}
+
+// ClassifySQLState classifies errors implementing SQLStater
+// by their SQLSTATE code.
+func ClassifySQLState(err error) (ErrorClass, string, bool) {
+	var stater SQLStater
+	if !errors.As(err, &stater) {
+		return "", "", false
+	}
+
+	code := stater.SQLState()
+
+	switch {
+	case code == "":
+		return "", "", false
+	case code == "40P01":
+		return ErrorClassDeadlock, code, true
+	case code == "40001":
+		return ErrorClassSerialization, code, true
+	case code == "57014":
+		return ErrorClassCanceled, code, true
+	case code == "HYT00" || code == "HYT01":
+		return ErrorClassTimeout, code, true
+	case strings.HasPrefix(code, "23"):
+		return ErrorClassConstraint, code, true
+	case strings.HasPrefix(code, "08"):
+		return ErrorClassConnection, code, true
+	case strings.HasPrefix(code, "42"):
+		return ErrorClassSyntax, code, true
+	default:
+		return "", code, true
+	}
+}
+
+// DefaultClassifiers is the list of built-in error classifiers.
+var DefaultClassifiers = []Classifier{ClassifyContext, ClassifyBadConn, ClassifySQLState}
+
+// Classify returns the class and code of err given by the first
+// classifier which knows it.
+func (l Logger) Classify(err error) (class ErrorClass, code string, ok bool) {
+	for _, classify := range l.Classifiers {
+		if class, code, ok = classify(err); ok {
+			return class, code, true
+		}
+	}
+
+	return "", "", false
+}
+
+// logError returns the attributes of err with its class and code.
+func (l Logger) logError(err error, attrs []slog.Attr) []slog.Attr {
+	attrs = append(attrs, slog.Any("error", err))
+
+	class, code, ok := l.Classify(err)
+	if !ok {
+		return attrs
+	}
+
+	if class != "" {
+		attrs = append(attrs, slog.String("errorClass", string(class)))
+	}
+
+	if code != "" {
+		attrs = append(attrs, slog.String("errorCode", code))
+	}
+
+	return attrs
+}
@@ -0,0 +1,106 @@
+package internal
+
+import "strings"
+
+// Fingerprint returns the normalized query: the comments are removed,
+// the string and numeric literals are replaced with "?", the whitespace
+// is kept only between words and the words are lowercased. The quoted
+// identifiers are kept as is.
+func Fingerprint(query string) string {
+	var b strings.Builder
+
+	space := false // whitespace after the previous word
+	word := false  // the previous token is a word
+
+	// write writes the token, separated by a space from the previous word only,
+	// so the whitespace around punctuation does not matter.
+	write := func(s string, isWord bool) {
+		if space && word && isWord {
+			b.WriteByte(' ')
+		}
+
+		space, word = false, isWord
+
+		b.WriteString(s)
+	}
+
+	for i := 0; i < len(query); {
+		c := query[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			space = true
+			i++
+		case c == '-' && strings.HasPrefix(query[i:], "--"):
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+
+			space = true
+		case c == '/' && strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				i = len(query)
+			} else {
+				i += end + 4
+			}
+
+			space = true
+		case c == '\'' || c == '"' || c == '`':
+			start := i
+			i = skipQuoted(query, i)
+
+			if c == '\'' {
+				write("?", true)
+			} else {
+				write(query[start:i], true)
+			}
+		case isDigit(c):
+			for i < len(query) && (isDigit(query[i]) || query[i] == '.') {
+				i++
+			}
+
+			write("?", true)
+		case isWord(c):
+			start := i
+			for i < len(query) && (isWord(query[i]) || isDigit(query[i])) {
+				i++
+			}
+
+			write(strings.ToLower(query[start:i]), true)
+		default:
+			write(query[i:i+1], c == '?')
+			i++
+		}
+	}
+
+	return b.String()
+}
+
+// skipQuoted returns the position after the quoted text starting at i.
+// The doubled quotes are escaped.
+func skipQuoted(query string, i int) int {
+	quote := query[i]
+
+	for i++; i < len(query); i++ {
+		if query[i] != quote {
+			continue
+		}
+
+		if i+1 < len(query) && query[i+1] == quote {
+			i++
+			continue
+		}
+
+		return i + 1
+	}
+
+	return i
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isWord(c byte) bool {
+	return c == '_' || c == '$' || c == '@' || c == ':' ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
@@ -0,0 +1,21 @@
+package internal
+
+import "testing"
+
+func TestFingerprint(t *testing.T) {
+	tests := []struct {
+		query, want string
+	}{
+		{"SELECT * FROM users WHERE id = 1", "select*from users where id=?"},
+		{"select *\n  from users -- comment\n where id = 42", "select*from users where id=?"},
+		{"SELECT /* hint */ name FROM t WHERE name = 'O''Brien'", "select name from t where name=?"},
+		{`SELECT "Name" FROM t WHERE x IN ($1, $2)`, `select "Name" from t where x in($1,$2)`},
+		{"INSERT INTO t VALUES (?, 3.14)", "insert into t values(?,?)"},
+	}
+
+	for _, tt := range tests {
+		if got := Fingerprint(tt.query); got != tt.want {
+			t.Errorf("Fingerprint(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
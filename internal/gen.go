@@ -0,0 +1,101 @@
+//go:build ignore
+
+// This program generates combos.go: the combinations of the wrappers with
+// the optional interfaces of the wrapped driver connections and statements.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+// optional is the optional driver interface with the type implementing it.
+type optional struct {
+	iface string   // checked driver interfaces
+	typ   string   // implementing type
+	alt   []string // alternative driver interfaces implemented by the type
+}
+
+var (
+	connOptionals = []optional{
+		{"driver.Pinger", "pinger", nil},
+		{"driver.ExecerContext", "execer", []string{"driver.Execer"}},
+		{"driver.QueryerContext", "queryer", []string{"driver.Queryer"}},
+		{"driver.SessionResetter", "sessionResetter", nil},
+		{"driver.NamedValueChecker", "namedValueChecker", nil},
+		{"driver.Validator", "validator", nil},
+	}
+	stmtOptionals = []optional{
+		{"driver.NamedValueChecker", "stmtNamedValueChecker", nil},
+		{"driver.ColumnConverter", "columnConverter", nil},
+	}
+)
+
+func main() {
+	var b bytes.Buffer
+
+	b.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\npackage internal\n\n")
+	b.WriteString("import \"database/sql/driver\"\n\n")
+
+	combos(&b, "wrapConn", "c", "Conn", "conn", "driver.Conn", connOptionals)
+	combos(&b, "wrapStmt", "s", "Stmt", "stmt", "driver.Stmt", stmtOptionals)
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("combos.go", src, 0o644); err != nil { //nolint:gosec // source file
+		log.Fatal(err)
+	}
+}
+
+// combos writes the function combining the wrapper with the types
+// of the optional interfaces implemented by the wrapped value.
+func combos(b *bytes.Buffer, fn, v, base, field, result string, optionals []optional) {
+	fmt.Fprintf(b, "// %s returns %s implementing the optional interfaces of its driver %s.\n", fn, v, field)
+	fmt.Fprintf(b, "func %s(%s *%s) %s {\n", fn, v, base, result)
+	b.WriteString("var set uint\n\n")
+
+	for i, o := range optionals {
+		ifaces := append([]string{o.iface}, o.alt...)
+		for _, iface := range ifaces {
+			nolint := ""
+			if strings.HasSuffix(iface, "Execer") || strings.HasSuffix(iface, "Queryer") ||
+				strings.HasSuffix(iface, "ColumnConverter") {
+				nolint = " //nolint:staticcheck // supported"
+			}
+
+			fmt.Fprintf(b, "if _, ok := %s.%s.(%s); ok {%s\nset |= %d\n}\n", v, field, iface, nolint, 1<<i)
+		}
+	}
+
+	b.WriteString("\nswitch set {\n")
+
+	for combo := 0; combo < 1<<len(optionals); combo++ {
+		if combo == 0 {
+			fmt.Fprintf(b, "case 0:\nreturn %s\n", v)
+			continue
+		}
+
+		var names, types, values []string
+
+		for i, o := range optionals {
+			if combo&(1<<i) != 0 {
+				names = append(names, o.typ)
+				types = append(types, o.typ)
+				values = append(values, fmt.Sprintf("%s{%s}", o.typ, v))
+			}
+		}
+
+		fmt.Fprintf(b, "case %d: // %s\nreturn struct{ *%s; %s }{%s, %s}\n",
+			combo, strings.Join(names, ", "), base, strings.Join(types, "; "),
+			v, strings.Join(values, ", "))
+	}
+
+	b.WriteString("default:\npanic(\"unreachable\")\n}\n}\n\n")
+}
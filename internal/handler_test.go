@@ -0,0 +1,107 @@
+package internal
+
+import (
+	"context"
+	"database/sql/driver"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/mdigger/sqlog/sqlogtest/fakedriver"
+)
+
+// recordHandler collects the handled records.
+type recordHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+	ids     []IDs // identifiers of the records from their context
+}
+
+func (h *recordHandler) Enabled(context.Context, slog.Level) bool { return true }
+func (h *recordHandler) WithAttrs([]slog.Attr) slog.Handler       { return h }
+func (h *recordHandler) WithGroup(string) slog.Handler            { return h }
+
+func (h *recordHandler) Handle(ctx context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.records = append(h.records, r.Clone())
+	ids, _ := IDsFromContext(ctx)
+	h.ids = append(h.ids, ids)
+
+	return nil
+}
+
+func (h *recordHandler) Records() []slog.Record {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]slog.Record(nil), h.records...)
+}
+
+// IDs returns the identifiers of the records.
+func (h *recordHandler) IDs() []IDs {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]IDs(nil), h.ids...)
+}
+
+// Messages returns the messages of the records.
+func (h *recordHandler) Messages() []string {
+	records := h.Records()
+	msgs := make([]string, len(records))
+
+	for i, r := range records {
+		msgs[i] = r.Message
+	}
+
+	return msgs
+}
+
+// Last returns the last record with the message and its attributes.
+func (h *recordHandler) Last(t *testing.T, msg string) (slog.Record, map[string]slog.Value) {
+	t.Helper()
+
+	records := h.Records()
+	for i := len(records) - 1; i >= 0; i-- {
+		if records[i].Message != msg {
+			continue
+		}
+
+		attrs := make(map[string]slog.Value)
+		records[i].Attrs(func(attr slog.Attr) bool {
+			attrs[attr.Key] = attr.Value
+			return true
+		})
+
+		return records[i], attrs
+	}
+
+	t.Fatalf("no %q record in %q", msg, h.Messages())
+
+	return slog.Record{}, nil
+}
+
+// openConn returns the connection of the new fake driver wrapped with
+// the logger configuration, the driver and the handler of the records.
+func openConn(t *testing.T, opts *fakedriver.Options, cfg *Config) (driver.Conn, *fakedriver.Driver, *recordHandler) {
+	t.Helper()
+
+	d := fakedriver.New(opts)
+
+	conn, err := d.Open("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := new(recordHandler)
+	if cfg == nil {
+		cfg = new(Config)
+	}
+
+	wrapped := NewConn(conn, "conn", Logger{Handler: h, Config: cfg})
+	d.ResetCalls()
+
+	return wrapped, d, h
+}
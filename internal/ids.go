@@ -0,0 +1,125 @@
+package internal
+
+import (
+	"context"
+	"sync"
+)
+
+type (
+	ctxIDsKey       struct{}
+	ctxIDsHolderKey struct{}
+)
+
+// IDs are the identifiers of the logged operation, passed to the handler
+// with the context of the record.
+type IDs struct {
+	ConnID string
+	StmtID string
+	TxID   string
+}
+
+// IDsFromContext returns the identifiers of the logged operation
+// from the context passed to the handler, or the identifiers of the last
+// operation with the context returned by ContextWithIDs.
+func IDsFromContext(ctx context.Context) (IDs, bool) {
+	if ids, ok := ctx.Value(ctxIDsKey{}).(IDs); ok {
+		return ids, true
+	}
+
+	if holder, ok := ctx.Value(ctxIDsHolderKey{}).(*idsHolder); ok {
+		return holder.get()
+	}
+
+	return IDs{}, false
+}
+
+// ContextWithIDs returns a copy of ctx that receives the identifiers
+// of the connection and transaction of the operations with it.
+func ContextWithIDs(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxIDsHolderKey{}, new(idsHolder))
+}
+
+// idsHolder holds the identifiers of the last operation with the context.
+type idsHolder struct {
+	mu  sync.Mutex
+	ids IDs
+	ok  bool
+}
+
+func (h *idsHolder) get() (IDs, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.ids, h.ok
+}
+
+func (h *idsHolder) set(ids IDs) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.ids, h.ok = ids, true
+}
+
+// setContextIDs passes the identifiers of the connection and its transaction
+// to the context returned by ContextWithIDs.
+func (c *Conn) setContextIDs(ctx context.Context) {
+	if c == nil {
+		return // statement without known connection
+	}
+
+	if holder, ok := ctx.Value(ctxIDsHolderKey{}).(*idsHolder); ok {
+		holder.set(IDs{ConnID: c.id, TxID: c.TxID()})
+	}
+}
+
+func contextWithIDs(ctx context.Context, ids IDs) context.Context {
+	if ids == (IDs{}) {
+		return ctx
+	}
+
+	return context.WithValue(ctx, ctxIDsKey{}, ids)
+}
+
+// ids returns the identifiers of the logged operation.
+func (l Logger) ids() IDs {
+	ids := IDs{ConnID: l.connID}
+
+	if l.conn != nil && l.conn.tx != nil {
+		ids.TxID = l.conn.tx.id.get()
+	}
+
+	if l.id != nil {
+		switch l.id.key {
+		case stmtIDKey:
+			ids.StmtID = l.id.get()
+		case txIDKey:
+			ids.TxID = l.id.get()
+		}
+	}
+
+	return ids
+}
+
+// ConnID returns the identifier of the connection.
+func (c *Conn) ConnID() string { return c.id }
+
+// TxID returns the identifier of the transaction in progress, if any.
+func (c *Conn) TxID() string {
+	if c.tx == nil {
+		return ""
+	}
+
+	return c.tx.id.get()
+}
+
+// ConnID returns the identifier of the connection of the statement, if known.
+func (s *Stmt) ConnID() string { return s.logger.connID }
+
+// StmtID returns the identifier of the statement.
+func (s *Stmt) StmtID() string { return s.id.get() }
+
+// ConnID returns the identifier of the connection of the transaction, if known.
+func (t *Tx) ConnID() string { return t.logger.connID }
+
+// TxID returns the identifier of the transaction.
+func (t *Tx) TxID() string { return t.id.get() }
@@ -4,50 +4,196 @@ import (
 	"context"
 	"database/sql/driver"
 	"errors"
+	"log/slog"
+	"math"
+	"runtime"
+	"strings"
 	"time"
-
-	"golang.org/x/exp/slog"
 )
 
+// Logger logs the operations of wrapped driver objects.
+// Its copies share the configuration.
 type Logger struct {
-	*slog.Logger
+	*Config
+	Handler slog.Handler
+
+	attrs  []slog.Attr // attributes added with With, replayed on a context logger
+	id     *lazyID     // identifier of the statement or transaction
+	connID string      // identifier of the connection
+	query  string      // query of the statement, if any
+	conn   *Conn       // connection with the transaction in progress, if known
+}
+
+// Config is the logger configuration.
+type Config struct {
 	BaseLevel    slog.Level
 	BasePrefix   string
 	StmtPrefix   string
 	TxPrefix     string
 	WithDuration bool
 	WarnErrSkip  bool
+	Comment      Commenter
+	Classifiers  []Classifier
+	ErrorLevels  []func(err error) (slog.Level, bool)
+	Retry        RetryPolicy
+	Breaker      BreakerPolicy
+	Dedup        *Dedup
+	Preview      PreviewPolicy
+	Redact       Redactor
+	Args         ArgsPolicy
+	Query        QueryPolicy
+	Recorder     *Recorder // recording of the driver calls, if enabled
+
+	NewID   func() string                    // generator of the identifiers, NewUID if nil
+	TraceID func(ctx context.Context) string // trace prefix of the identifiers, if any
+
+	DefaultTimeout time.Duration        // default timeout of statements without deadline
+	Timeouts       map[Op]time.Duration // per-operation timeouts overriding the default
+}
+
+// Enabled reports whether the record of the operation with the level and
+// error is logged. It allows to skip the computing of record attributes.
+func (l Logger) Enabled(ctx context.Context, level slog.Level, err error) bool {
+	_, _, _, ok := l.resolve(ctx, level, err)
+	return ok
+}
+
+// resolve returns the handler and the final level of the record.
+// The replay flag reports that the handler is from the context and
+// the attributes of the logger must be added to it.
+func (l Logger) resolve(ctx context.Context, level slog.Level, err error) (_ slog.Handler, _ slog.Level, replay, ok bool) {
+	if isSilent(ctx) {
+		return nil, 0, false, false
+	}
+
+	handler := l.Handler
+	if log, ok := loggerFromContext(ctx); ok {
+		handler, replay = log.Handler(), true
+	}
+
+	if handler == nil {
+		return nil, 0, false, false
+	}
+
+	if base, ok := levelFromContext(ctx); ok {
+		level = base + level
+	} else {
+		level = l.BaseLevel + level
+	}
+
+	if err != nil {
+		if level, ok = l.errorLevel(err); !ok {
+			return nil, 0, false, false
+		}
+	}
+
+	if !handler.Enabled(ctx, level) {
+		return nil, 0, false, false
+	}
+
+	return handler, level, replay, true
 }
 
 func (l Logger) Log(ctx context.Context, level slog.Level, msg string, started time.Time, err error, attrs ...slog.Attr) {
-	if l.Logger == nil {
+	handler, level, replay, ok := l.resolve(ctx, level, err)
+	if !ok {
 		return
 	}
 
-	if l.WithDuration && !started.IsZero() {
-		attrs = append(attrs, slog.Duration("duration", time.Since(started)))
+	if replay && len(l.attrs) > 0 {
+		handler = handler.WithAttrs(l.attrs)
 	}
 
-	level = l.BaseLevel + level
+	var elapsed time.Duration
+	if !started.IsZero() {
+		elapsed = time.Since(started)
+	}
+
+	var query string // fingerprint of the original query, before formatting
+	if err != nil && l.Dedup != nil {
+		query = l.fingerprint(attrs)
+	}
+
+	full := err != nil && l.Query.FullOnError ||
+		l.Query.FullOnSlow > 0 && elapsed >= l.Query.FullOnSlow
+	attrs = l.Query.format(attrs, full)
+
+	if l.WithDuration && !started.IsZero() {
+		attrs = append(attrs, slog.Duration("duration", elapsed))
+	}
 
 	if err != nil {
-		level = slog.LevelError
+		attrs = l.logError(err, attrs)
+	}
+
+	r := slog.NewRecord(time.Now(), level, l.BasePrefix+msg, callerPC())
+	if l.id != nil {
+		r.AddAttrs(l.id.attr())
+	}
+	r.AddAttrs(attrs...)
+
+	ids := l.ids()
+	if err != nil && l.Dedup != nil && l.Dedup.repeated(handler, r, err, query, ids) {
+		return
+	}
+
+	ctx = contextWithIDs(ctx, ids)
+
+	_ = handler.Handle(ctx, r) //nolint:errcheck // nothing to do with it
+}
 
-		if errors.Is(err, driver.ErrSkip) {
-			if !l.WarnErrSkip {
-				return
-			}
+// LevelDiscard is the error level to drop the log record.
+const LevelDiscard = slog.Level(math.MinInt)
 
-			level = slog.LevelWarn
+// errorLevel returns the level of the record with err.
+// It returns false, if the record must be dropped.
+func (l Logger) errorLevel(err error) (slog.Level, bool) {
+	for _, errorLevel := range l.ErrorLevels {
+		if level, ok := errorLevel(err); ok {
+			return level, level != LevelDiscard
 		}
+	}
 
-		attrs = append(attrs, slog.Any("error", err))
+	if errors.Is(err, driver.ErrSkip) {
+		return slog.LevelWarn, l.WarnErrSkip
 	}
 
-	l.Logger.LogAttrs(ctx, level, l.BasePrefix+msg, attrs...)
+	return slog.LevelError, true
 }
 
-func (l Logger) With(attrs ...any) Logger {
-	l.Logger = l.Logger.With(attrs...)
+func (l Logger) With(attrs ...slog.Attr) Logger {
+	if l.Handler != nil {
+		l.Handler = l.Handler.WithAttrs(attrs)
+	}
+
+	l.attrs = append(l.attrs[:len(l.attrs):len(l.attrs)], attrs...)
 	return l
 }
+
+// callerPC returns the program counter of the application call site:
+// the first caller outside of the database/sql and sqlog packages.
+// The program counters are checked one by one, as the handlers symbolize
+// them: runtime.Callers returns the own program counter of the call site
+// of the inlined database/sql function, but Frame.PC of that call site
+// is resolved to the inlined function.
+func callerPC() uintptr {
+	var pcs [64]uintptr
+
+	n := runtime.Callers(3, pcs[:]) // skip runtime.Callers, callerPC and Logger.Log
+
+	for _, pc := range pcs[:n] {
+		frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+		if !isInternalFrame(frame.Function) {
+			return pc
+		}
+	}
+
+	return 0
+}
+
+func isInternalFrame(function string) bool {
+	return strings.HasPrefix(function, "database/sql.") ||
+		strings.HasPrefix(function, "github.com/mdigger/sqlog.") ||
+		strings.HasPrefix(function, "github.com/mdigger/sqlog/") ||
+		strings.HasPrefix(function, "runtime.")
+}
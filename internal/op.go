@@ -0,0 +1,20 @@
+package internal
+
+// Op is a set of wrapped driver operations.
+type Op uint
+
+const (
+	OpConnect Op = 1 << iota // Connector.Connect and Driver.Open
+	OpPing                   // Conn.Ping
+	OpExec                   // Conn and Stmt Exec
+	OpQuery                  // Conn and Stmt Query
+	OpPrepare                // Conn.Prepare
+	OpBegin                  // Conn.Begin
+
+	OpAll = OpConnect | OpPing | OpExec | OpQuery | OpPrepare | OpBegin
+)
+
+// Has reports whether all operations of op are in the set.
+func (o Op) Has(op Op) bool {
+	return op != 0 && o&op == op
+}
@@ -0,0 +1,102 @@
+package internal
+
+import (
+	"database/sql/driver"
+	"log/slog"
+	"strconv"
+	"unicode/utf8"
+)
+
+// PreviewPolicy describes the preview of query results.
+type PreviewPolicy struct {
+	Rows        int // number of recorded rows, zero disables the preview
+	MaxValueLen int // maximum length of string and []byte values, if not zero
+}
+
+// Redactor returns the value of the named column or argument to log,
+// such as a masked password.
+type Redactor func(name string, value driver.Value) driver.Value
+
+// preview records the first rows of the query result.
+type preview struct {
+	policy  PreviewPolicy
+	redact  Redactor
+	columns []string
+	rows    [][]driver.Value
+}
+
+// add records the copy of the row, if the limit is not reached.
+func (p *preview) add(rows driver.Rows, dest []driver.Value) {
+	if len(p.rows) >= p.policy.Rows {
+		return
+	}
+
+	if p.columns == nil {
+		p.columns = rows.Columns()
+	}
+
+	row := make([]driver.Value, len(dest))
+	for i, value := range dest {
+		if p.redact != nil && i < len(p.columns) {
+			value = p.redact(p.columns[i], value)
+		}
+
+		row[i] = truncateValue(value, p.policy.MaxValueLen)
+	}
+
+	p.rows = append(p.rows, row)
+}
+
+// attr returns the preview attribute: the group of rows by their numbers,
+// each one is the group of column values.
+func (p *preview) attr() slog.Attr {
+	rows := make([]slog.Attr, len(p.rows))
+	for i, row := range p.rows {
+		values := make([]slog.Attr, len(row))
+		for j, value := range row {
+			name := strconv.Itoa(j)
+			if j < len(p.columns) {
+				name = p.columns[j]
+			}
+
+			values[j] = slog.Any(name, value)
+		}
+
+		rows[i] = slog.Attr{Key: strconv.Itoa(i), Value: slog.GroupValue(values...)}
+	}
+
+	return slog.Attr{Key: "preview", Value: slog.GroupValue(rows...)}
+}
+
+// truncateValue returns the value with strings and []byte truncated to max
+// length. The []byte values are copied as strings, because the driver may
+// reuse their buffers.
+func truncateValue(value driver.Value, max int) driver.Value {
+	switch v := value.(type) {
+	case string:
+		return truncateString(v, max)
+	case []byte:
+		if max > 0 && len(v) > max+utf8.UTFMax {
+			v = v[:max+utf8.UTFMax] // enough to cut the whole rune
+		}
+
+		return truncateString(string(v), max)
+	default:
+		return value
+	}
+}
+
+// truncateString returns s truncated to max bytes with the ellipsis,
+// keeping the UTF-8 runes whole.
+func truncateString(s string, max int) string {
+	if max <= 0 || len(s) <= max {
+		return s
+	}
+
+	cut := max
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+
+	return s[:cut] + "…"
+}
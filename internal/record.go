@@ -0,0 +1,289 @@
+package internal
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// Recording format of driver-level calls: JSON Lines, started with
+// the RecordHeader line and followed by Event lines.
+const (
+	RecordFormat  = "sqlog"
+	RecordVersion = 1
+)
+
+// RecordHeader is the first line of the recording.
+type RecordHeader struct {
+	Format  string    `json:"format"`
+	Version int       `json:"version"`
+	Time    time.Time `json:"time"`
+}
+
+// Recorded operations.
+const (
+	EventConnect  = "connect"
+	EventPrepare  = "prepare"
+	EventExec     = "exec"
+	EventQuery    = "query"
+	EventRows     = "rows"
+	EventBegin    = "begin"
+	EventCommit   = "commit"
+	EventRollback = "rollback"
+	EventClose    = "close"
+)
+
+// Event is the recorded driver call.
+type Event struct {
+	Seq          int64     `json:"seq"`
+	Time         time.Time `json:"time"`
+	Op           string    `json:"op"`
+	ConnID       string    `json:"connID,omitempty"`
+	StmtID       string    `json:"stmtID,omitempty"`
+	TxID         string    `json:"txID,omitempty"`
+	Ref          int64     `json:"ref,omitempty"` // query of the rows
+	Query        string    `json:"query,omitempty"`
+	Args         []Value   `json:"args,omitempty"`
+	Columns      []string  `json:"columns,omitempty"`
+	Rows         [][]Value `json:"rows,omitempty"`
+	RowsAffected *int64    `json:"rowsAffected,omitempty"`
+	LastInsertID *int64    `json:"lastInsertId,omitempty"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// Value is the recorded driver.Value, encoded in JSON with its type:
+// {"int64":1}, {"float64":1.5}, {"bool":true}, {"string":"s"},
+// {"bytes":"base64"}, {"time":"RFC3339"} or null.
+type Value struct {
+	driver.Value
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v Value) MarshalJSON() ([]byte, error) {
+	switch x := v.Value.(type) {
+	case nil:
+		return []byte("null"), nil
+	case int64:
+		return json.Marshal(map[string]int64{"int64": x})
+	case float64:
+		return json.Marshal(map[string]float64{"float64": x})
+	case bool:
+		return json.Marshal(map[string]bool{"bool": x})
+	case string:
+		return json.Marshal(map[string]string{"string": x})
+	case []byte:
+		return json.Marshal(map[string]string{"bytes": base64.StdEncoding.EncodeToString(x)})
+	case time.Time:
+		return json.Marshal(map[string]time.Time{"time": x})
+	default: // not converted argument
+		return json.Marshal(map[string]string{"string": fmt.Sprint(x)})
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Value) UnmarshalJSON(data []byte) error {
+	var typed struct {
+		Int64   *int64     `json:"int64"`
+		Float64 *float64   `json:"float64"`
+		Bool    *bool      `json:"bool"`
+		String  *string    `json:"string"`
+		Bytes   *string    `json:"bytes"`
+		Time    *time.Time `json:"time"`
+	}
+
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return err
+	}
+
+	switch {
+	case typed.Int64 != nil:
+		v.Value = *typed.Int64
+	case typed.Float64 != nil:
+		v.Value = *typed.Float64
+	case typed.Bool != nil:
+		v.Value = *typed.Bool
+	case typed.String != nil:
+		v.Value = *typed.String
+	case typed.Bytes != nil:
+		b, err := base64.StdEncoding.DecodeString(*typed.Bytes)
+		if err != nil {
+			return err
+		}
+
+		v.Value = b
+	case typed.Time != nil:
+		v.Value = *typed.Time
+	default:
+		v.Value = nil
+	}
+
+	return nil
+}
+
+// Recorder writes driver calls to the recording. Its methods do nothing
+// for the nil Recorder. The recording stops after the first write error.
+type Recorder struct {
+	OnError func(err error) // called once with the first write error, if set
+
+	mu       sync.Mutex
+	enc      *json.Encoder
+	seq      int64
+	err      error
+	reported bool
+}
+
+// NewRecorder returns a new Recorder writing to w.
+func NewRecorder(w io.Writer) *Recorder {
+	r := &Recorder{enc: json.NewEncoder(w)}
+	r.err = r.enc.Encode(RecordHeader{
+		Format:  RecordFormat,
+		Version: RecordVersion,
+		Time:    time.Now(),
+	})
+
+	return r
+}
+
+// Err returns the first write error.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.err
+}
+
+// Record writes the event with the call arguments and error.
+// The skipped calls are not recorded. It returns the sequence number
+// of the event.
+func (r *Recorder) Record(e Event, args any, err error) int64 {
+	if r == nil || errors.Is(err, driver.ErrSkip) {
+		return 0
+	}
+
+	e.Args = recordArgs(args)
+	if err != nil {
+		e.Error = err.Error()
+	}
+
+	return r.write(e)
+}
+
+// RecordExec writes the exec event with its result.
+func (r *Recorder) RecordExec(e Event, args any, result driver.Result, err error) {
+	if r == nil {
+		return
+	}
+
+	if err == nil && result != nil {
+		if n, err := result.RowsAffected(); err == nil {
+			e.RowsAffected = &n
+		}
+
+		if id, err := result.LastInsertId(); err == nil {
+			e.LastInsertID = &id
+		}
+	}
+
+	r.Record(e, args, err)
+}
+
+// RecordQuery writes the query event and returns the recorder of its rows.
+func (r *Recorder) RecordQuery(e Event, args any, err error) *rowsRecord {
+	seq := r.Record(e, args, err)
+	if seq == 0 || err != nil {
+		return nil
+	}
+
+	return &rowsRecord{recorder: r, ref: seq}
+}
+
+func (r *Recorder) write(e Event) int64 {
+	r.mu.Lock()
+
+	if r.err == nil {
+		r.seq++
+		e.Seq = r.seq
+		e.Time = time.Now()
+
+		if r.err = r.enc.Encode(e); r.err == nil {
+			r.mu.Unlock()
+			return e.Seq
+		}
+	}
+
+	// the header write error is also reported on the first event
+	err, report := r.err, !r.reported
+	r.reported = true
+	r.mu.Unlock()
+
+	if report && r.OnError != nil {
+		r.OnError(err)
+	}
+
+	return 0
+}
+
+// rowsRecord records the rows of the query, written on close.
+type rowsRecord struct {
+	recorder *Recorder
+	ref      int64
+	columns  []string
+	rows     [][]Value
+	err      error
+}
+
+// add records the copy of the row.
+func (r *rowsRecord) add(rows driver.Rows, dest []driver.Value) {
+	if r.columns == nil {
+		r.columns = rows.Columns()
+	}
+
+	row := make([]Value, len(dest))
+	for i, value := range dest {
+		if b, ok := value.([]byte); ok {
+			value = append([]byte(nil), b...) // the driver may reuse its buffer
+		}
+
+		row[i] = Value{value}
+	}
+
+	r.rows = append(r.rows, row)
+}
+
+// close writes the recorded rows before the rows are closed.
+func (r *rowsRecord) close(rows driver.Rows) {
+	if r.columns == nil {
+		r.columns = rows.Columns()
+	}
+
+	e := Event{Op: EventRows, Ref: r.ref, Columns: r.columns, Rows: r.rows}
+	if r.err != nil && !errors.Is(r.err, io.EOF) {
+		e.Error = r.err.Error()
+	}
+
+	r.recorder.write(e)
+}
+
+func recordArgs(args any) []Value {
+	var values []Value
+
+	switch args := args.(type) {
+	case []driver.NamedValue:
+		values = make([]Value, len(args))
+		for i, arg := range args {
+			values[i] = Value{arg.Value}
+		}
+	case []driver.Value:
+		values = make([]Value, len(args))
+		for i, arg := range args {
+			values[i] = Value{arg}
+		}
+	}
+
+	return values
+}
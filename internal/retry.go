@@ -0,0 +1,130 @@
+package internal
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"log/slog"
+	"math"
+	"math/rand"
+	"time"
+)
+
+type ctxRetryKey struct{}
+
+// ContextWithRetry returns a copy of ctx, which marks the queries
+// as idempotent and safe to retry.
+func ContextWithRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxRetryKey{}, true)
+}
+
+func isRetryable(ctx context.Context) bool {
+	retry, _ := ctx.Value(ctxRetryKey{}).(bool)
+	return retry
+}
+
+// RetryPolicy describes the retrying of transient failures.
+type RetryPolicy struct {
+	Ops         Op                   // retried operations: OpConnect, OpPing and OpQuery
+	MaxAttempts int                  // maximum number of attempts, including the first one
+	MinBackoff  time.Duration        // delay before the second attempt, doubled for each next
+	MaxBackoff  time.Duration        // maximum delay between attempts, if not zero
+	Jitter      float64              // random fraction of the delay subtracted from it, [0, 1]
+	Transient   func(err error) bool // reports whether err is transient, by default its class
+}
+
+// Transient reports whether the errors of the class are transient
+// and the operation may succeed on retry.
+func (c ErrorClass) Transient() bool {
+	switch c {
+	case ErrorClassConnection, ErrorClassDeadlock, ErrorClassSerialization:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff returns the delay after the failed attempt.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.MinBackoff << (attempt - 1)
+	if delay>>(attempt-1) != p.MinBackoff { // overflow
+		delay = math.MaxInt64
+	}
+
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+
+	if p.Jitter > 0 {
+		delay -= time.Duration(rand.Float64() * p.Jitter * float64(delay)) //nolint:gosec // jitter
+	}
+
+	return delay
+}
+
+// sameSession reports whether the operation may succeed on retry using
+// the same connection. Only deadlocks and serialization failures are
+// resolved by the server, a lost connection stays lost.
+func (c ErrorClass) sameSession() bool {
+	return c == ErrorClassDeadlock || c == ErrorClassSerialization
+}
+
+// transient reports whether err is transient according to the retry policy.
+// The operations other than OpConnect are retried on the same connection,
+// so driver.ErrBadConn is returned at once: database/sql retries it
+// on another connection.
+func (l Logger) transient(op Op, err error) bool {
+	if op != OpConnect && errors.Is(err, driver.ErrBadConn) {
+		return false
+	}
+
+	if l.Retry.Transient != nil {
+		return l.Retry.Transient(err)
+	}
+
+	class, _, ok := l.Classify(err)
+	if !ok {
+		return false
+	}
+
+	if op != OpConnect {
+		return class.sameSession()
+	}
+
+	return class.Transient()
+}
+
+// retry calls fn until it succeeds, fails with a not transient error or
+// the attempts are exhausted. Each failed attempt is logged as a warning.
+// It returns the number of the last attempt.
+func (l Logger) retry(ctx context.Context, op Op, msg string, fn func() error) (attempt int, err error) {
+	for attempt = 1; ; attempt++ {
+		started := time.Now()
+
+		err = fn()
+		if err == nil || !l.Retry.Ops.Has(op) || attempt >= l.Retry.MaxAttempts || !l.transient(op, err) {
+			return attempt, err
+		}
+
+		delay := l.Retry.backoff(attempt)
+		l.Log(ctx, slog.LevelWarn, msg, started, nil,
+			l.logError(err, []slog.Attr{slog.Int("attempt", attempt), slog.Duration("retryIn", delay)})...)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return attempt, err
+		}
+	}
+}
+
+// logAttempt returns the attempt attribute of retried operations.
+func logAttempt(attempt int) slog.Attr {
+	if attempt <= 1 {
+		return slog.Attr{}
+	}
+
+	return slog.Int("attempt", attempt)
+}
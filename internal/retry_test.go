@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+type sqlStateError string
+
+func (e sqlStateError) Error() string    { return "sqlstate " + string(e) }
+func (e sqlStateError) SQLState() string { return string(e) }
+
+func TestTransient(t *testing.T) {
+	logger := Logger{Config: &Config{Classifiers: DefaultClassifiers}}
+	badConn := fmt.Errorf("ping: %w", driver.ErrBadConn)
+
+	tests := []struct {
+		op   Op
+		err  error
+		want bool
+	}{
+		{OpConnect, badConn, true},
+		{OpPing, badConn, false},
+		{OpQuery, badConn, false},
+		{OpConnect, sqlStateError("08006"), true},
+		{OpQuery, sqlStateError("08006"), false},
+		{OpQuery, sqlStateError("40P01"), true},
+		{OpQuery, sqlStateError("40001"), true},
+		{OpQuery, errors.New("unknown"), false},
+	}
+
+	for _, tt := range tests {
+		if got := logger.transient(tt.op, tt.err); got != tt.want {
+			t.Errorf("transient(%v, %v) = %v, want %v", tt.op, tt.err, got, tt.want)
+		}
+	}
+
+	logger.Retry.Transient = func(error) bool { return true }
+	if logger.transient(OpQuery, badConn) {
+		t.Error("driver.ErrBadConn must not be retried on the same connection")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		policy  RetryPolicy
+		attempt int
+		want    time.Duration
+	}{
+		{RetryPolicy{MinBackoff: time.Millisecond}, 1, time.Millisecond},
+		{RetryPolicy{MinBackoff: time.Millisecond}, 4, 8 * time.Millisecond},
+		{RetryPolicy{MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}, 4, 5 * time.Millisecond},
+		{RetryPolicy{MinBackoff: time.Second}, 40, math.MaxInt64},
+		{RetryPolicy{MinBackoff: time.Second}, 100, math.MaxInt64},
+		{RetryPolicy{MinBackoff: time.Second, MaxBackoff: time.Minute}, 40, time.Minute},
+		{RetryPolicy{}, 100, 0},
+	}
+
+	for _, tt := range tests {
+		if got := tt.policy.backoff(tt.attempt); got != tt.want {
+			t.Errorf("backoff(%d) of %+v = %v, want %v", tt.attempt, tt.policy, got, tt.want)
+		}
+	}
+
+	jittered := RetryPolicy{MinBackoff: time.Second, Jitter: 0.5}
+	for i := 0; i < 100; i++ {
+		if got := jittered.backoff(100); got <= 0 {
+			t.Fatalf("jittered backoff = %v, want positive", got)
+		}
+	}
+}
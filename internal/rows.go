@@ -0,0 +1,193 @@
+package internal
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"log/slog"
+	"reflect"
+	"time"
+)
+
+// Rows is an iterator over an executed query's results.
+type Rows struct {
+	rows    driver.Rows
+	cancel  context.CancelFunc
+	ctx     context.Context //nolint:containedctx // used for logging on close
+	logger  Logger
+	preview *preview    // the first rows, if enabled
+	record  *rowsRecord // recorded rows, if enabled
+	count   int         // number of read rows
+}
+
+// NewRows returns a new wrapped Rows. The cancel function is called,
+// when the rows are closed.
+func NewRows(rows driver.Rows, cancel context.CancelFunc) *Rows {
+	return &Rows{
+		rows:   rows,
+		cancel: cancel,
+	}
+}
+
+var (
+	_ driver.Rows                           = (*Rows)(nil)
+	_ driver.RowsNextResultSet              = (*Rows)(nil)
+	_ driver.RowsColumnTypeScanType         = (*Rows)(nil)
+	_ driver.RowsColumnTypeDatabaseTypeName = (*Rows)(nil)
+	_ driver.RowsColumnTypeLength           = (*Rows)(nil)
+	_ driver.RowsColumnTypeNullable         = (*Rows)(nil)
+	_ driver.RowsColumnTypePrecisionScale   = (*Rows)(nil)
+)
+
+// wrapRows wraps the rows of the query, if the cancel function of its context
+// must be called on close, the preview or the recording is enabled.
+func (l Logger) wrapRows(ctx context.Context, rows driver.Rows, err error, cancel context.CancelFunc,
+	record *rowsRecord,
+) (driver.Rows, error) {
+	if err != nil {
+		if cancel != nil {
+			cancel()
+		}
+
+		return nil, err
+	}
+
+	withPreview := l.Preview.Rows > 0 && l.Enabled(ctx, slog.LevelDebug, nil)
+	if cancel == nil && !withPreview && record == nil {
+		return rows, nil
+	}
+
+	r := NewRows(rows, cancel)
+	r.record = record
+
+	if withPreview {
+		r.ctx = ctx
+		r.logger = l
+		r.preview = &preview{policy: l.Preview, redact: l.Redact}
+	}
+
+	return r, nil
+}
+
+// Unwrap returns the driver rows.
+func (r *Rows) Unwrap() driver.Rows {
+	return r.rows
+}
+
+// Columns returns the names of the columns.
+func (r *Rows) Columns() []string {
+	return r.rows.Columns()
+}
+
+// Close closes the rows iterator.
+func (r *Rows) Close() (err error) {
+	if r.cancel != nil {
+		defer r.cancel()
+	}
+
+	if r.record != nil {
+		r.record.close(r.rows) // columns are still available
+		r.record = nil         // recorded once
+	}
+
+	if r.preview != nil {
+		defer func() {
+			r.logger.Log(r.ctx, slog.LevelDebug, "rows", time.Time{}, err,
+				slog.Int("rows", r.count), r.preview.attr())
+			r.preview = nil // logged once
+		}()
+	}
+
+	return r.rows.Close()
+}
+
+// Next is called to populate the next row of data into
+// the provided slice.
+func (r *Rows) Next(dest []driver.Value) error {
+	if err := r.rows.Next(dest); err != nil {
+		if r.record != nil {
+			r.record.err = err
+		}
+
+		return err
+	}
+
+	r.count++
+
+	if r.record != nil {
+		r.record.add(r.rows, dest)
+	}
+
+	if r.preview != nil {
+		r.preview.add(r.rows, dest)
+	}
+
+	return nil
+}
+
+// HasNextResultSet is called at the end of the current result set and
+// reports whether there is another result set after the current one.
+func (r *Rows) HasNextResultSet() bool {
+	if rs, ok := r.rows.(driver.RowsNextResultSet); ok {
+		return rs.HasNextResultSet()
+	}
+
+	return false
+}
+
+// NextResultSet advances the driver to the next result set even
+// if there are remaining rows in the current result set.
+func (r *Rows) NextResultSet() error {
+	if rs, ok := r.rows.(driver.RowsNextResultSet); ok {
+		return rs.NextResultSet()
+	}
+
+	return io.EOF
+}
+
+// ColumnTypeScanType returns the value type that can be used to scan types into.
+func (r *Rows) ColumnTypeScanType(index int) reflect.Type {
+	if rs, ok := r.rows.(driver.RowsColumnTypeScanType); ok {
+		return rs.ColumnTypeScanType(index)
+	}
+
+	return reflect.TypeOf(new(any)).Elem() // same as database/sql default
+}
+
+// ColumnTypeDatabaseTypeName returns the database system type name
+// without the length.
+func (r *Rows) ColumnTypeDatabaseTypeName(index int) string {
+	if rs, ok := r.rows.(driver.RowsColumnTypeDatabaseTypeName); ok {
+		return rs.ColumnTypeDatabaseTypeName(index)
+	}
+
+	return ""
+}
+
+// ColumnTypeLength returns the length of the column type if the column
+// is a variable length type.
+func (r *Rows) ColumnTypeLength(index int) (length int64, ok bool) {
+	if rs, ok := r.rows.(driver.RowsColumnTypeLength); ok {
+		return rs.ColumnTypeLength(index)
+	}
+
+	return 0, false
+}
+
+// ColumnTypeNullable reports whether the column may be null.
+func (r *Rows) ColumnTypeNullable(index int) (nullable, ok bool) {
+	if rs, ok := r.rows.(driver.RowsColumnTypeNullable); ok {
+		return rs.ColumnTypeNullable(index)
+	}
+
+	return false, false
+}
+
+// ColumnTypePrecisionScale returns the precision and scale for decimal types.
+func (r *Rows) ColumnTypePrecisionScale(index int) (precision, scale int64, ok bool) {
+	if rs, ok := r.rows.(driver.RowsColumnTypePrecisionScale); ok {
+		return rs.ColumnTypePrecisionScale(index)
+	}
+
+	return 0, 0, false
+}
@@ -0,0 +1,166 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/mdigger/sqlog/sqlogtest/fakedriver"
+)
+
+// usersRows returns two rows of users.
+func usersRows(string, []driver.NamedValue) ([]string, [][]driver.Value) {
+	return []string{"id", "name"}, [][]driver.Value{{int64(1), "alice"}, {int64(2), "bob"}}
+}
+
+// readRows reads and closes the rows, returning their number.
+func readRows(t *testing.T, rows driver.Rows) int {
+	t.Helper()
+
+	var n int
+
+	dest := make([]driver.Value, len(rows.Columns()))
+	for {
+		err := rows.Next(dest)
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		n++
+	}
+
+	if err := rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return n
+}
+
+func TestRowsNotWrapped(t *testing.T) {
+	conn, _, _ := openConn(t, &fakedriver.Options{
+		Interfaces: fakedriver.QueryerContext,
+		Rows:       usersRows,
+	}, nil)
+
+	rows, err := conn.(driver.QueryerContext).QueryContext(context.Background(), "SELECT * FROM users", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := rows.(*Rows); ok {
+		t.Error("the rows are wrapped without the timeout, preview and recording")
+	}
+
+	if n := readRows(t, rows); n != 2 {
+		t.Errorf("read %d rows, want 2", n)
+	}
+}
+
+func TestRowsTimeout(t *testing.T) {
+	conn, _, _ := openConn(t, &fakedriver.Options{
+		Interfaces: fakedriver.QueryerContext,
+		Rows:       usersRows,
+	}, &Config{DefaultTimeout: time.Minute})
+
+	rows, err := conn.(driver.QueryerContext).QueryContext(context.Background(), "SELECT * FROM users", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the context of the timeout is canceled on close
+	wrapped, ok := rows.(*Rows)
+	if !ok || wrapped.cancel == nil {
+		t.Fatalf("rows = %T, want wrapped with the cancel function", rows)
+	}
+
+	if _, ok := wrapped.Unwrap().(*Rows); ok {
+		t.Error("Unwrap returns the wrapped rows")
+	}
+
+	if n := readRows(t, rows); n != 2 {
+		t.Errorf("read %d rows, want 2", n)
+	}
+}
+
+func TestRowsPreview(t *testing.T) {
+	conn, _, h := openConn(t, &fakedriver.Options{
+		Interfaces: fakedriver.QueryerContext,
+		Rows:       usersRows,
+	}, &Config{Preview: PreviewPolicy{Rows: 1}})
+
+	rows, err := conn.(driver.QueryerContext).QueryContext(context.Background(), "SELECT * FROM users", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := readRows(t, rows); n != 2 {
+		t.Errorf("read %d rows, want 2", n)
+	}
+
+	_, attrs := h.Last(t, "rows")
+	if attrs["rows"].Int64() != 2 {
+		t.Errorf("rows = %v, want 2", attrs["rows"])
+	}
+
+	if _, ok := attrs["preview"]; !ok {
+		t.Errorf("no preview in %v", attrs)
+	}
+}
+
+func TestRowsRecord(t *testing.T) {
+	var buf bytes.Buffer
+
+	conn, _, _ := openConn(t, &fakedriver.Options{
+		Interfaces: fakedriver.ConnPrepareContext | fakedriver.StmtQueryContext,
+		Rows:       usersRows,
+	}, &Config{Recorder: NewRecorder(&buf)})
+
+	rows, err := prepare(t, conn, "SELECT * FROM users").(driver.StmtQueryContext).
+		QueryContext(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := readRows(t, rows); n != 2 {
+		t.Errorf("read %d rows, want 2", n)
+	}
+
+	dec := json.NewDecoder(&buf)
+
+	var header RecordHeader
+	if err := dec.Decode(&header); err != nil || header.Format != RecordFormat {
+		t.Fatalf("header = %+v, %v", header, err)
+	}
+
+	var events []Event
+
+	for dec.More() {
+		var e Event
+		if err := dec.Decode(&e); err != nil {
+			t.Fatal(err)
+		}
+
+		events = append(events, e)
+	}
+
+	if len(events) != 3 {
+		t.Fatalf("recorded %d events, want prepare, query and rows", len(events))
+	}
+
+	query, rs := events[1], events[2]
+	if query.Op != EventQuery || rs.Op != EventRows || rs.Ref != query.Seq {
+		t.Errorf("unexpected events %+v and %+v", query, rs)
+	}
+
+	if len(rs.Columns) != 2 || len(rs.Rows) != 2 {
+		t.Errorf("recorded %d columns and %d rows, want 2 and 2", len(rs.Columns), len(rs.Rows))
+	}
+}
@@ -3,32 +3,67 @@ package internal
 import (
 	"context"
 	"database/sql/driver"
+	"log/slog"
 	"time"
-
-	"golang.org/x/exp/slog"
 )
 
 type Stmt struct {
 	stmt   driver.Stmt
 	query  string
 	logger Logger
+	conn   *Conn           // connection of the statement, if known
+	ctx    context.Context // logging overrides of the preparation
+	id     lazyID
 }
 
 func NewStmt(stmt driver.Stmt, query string, logger Logger) *Stmt {
-	return &Stmt{
+	s := &Stmt{
 		stmt:   stmt,
 		query:  query,
 		logger: logger,
+		ctx:    context.Background(),
+		id:     lazyID{key: stmtIDKey},
 	}
+	s.logger.id = &s.id
+	s.logger.query = query
+
+	return s
+}
+
+// logID returns the statement identifier attribute.
+// The nil statement, failed to prepare, has a new identifier.
+func (s *Stmt) logID(newID func() string) slog.Attr {
+	if s == nil {
+		return slog.String(stmtIDKey, newID())
+	}
+
+	return s.id.attr()
 }
 
 var (
-	_ driver.Stmt              = (*Stmt)(nil)
-	_ driver.StmtExecContext   = (*Stmt)(nil)
-	_ driver.StmtQueryContext  = (*Stmt)(nil)
-	_ driver.NamedValueChecker = (*Stmt)(nil)
+	_ driver.Stmt             = (*Stmt)(nil)
+	_ driver.StmtExecContext  = (*Stmt)(nil)
+	_ driver.StmtQueryContext = (*Stmt)(nil)
+)
+
+// The optional interfaces of the driver statement, combined with the Stmt
+// by wrapStmt only if its driver statement implements them. Otherwise
+// the database/sql package checks the arguments with the connection.
+type (
+	stmtNamedValueChecker struct{ *Stmt }
+	columnConverter       struct{ *Stmt }
 )
 
+var (
+	_ driver.NamedValueChecker = stmtNamedValueChecker{}
+	_ driver.ColumnConverter   = columnConverter{} //nolint:staticcheck // forwarded
+)
+
+// Unwrap returns the driver statement.
+func (s *Stmt) Unwrap() driver.Stmt {
+	return s.stmt
+}
+
 // Close closes the statement.
 //
 // As of Go 1.1, a Stmt will not be closed if it's in use
@@ -38,7 +73,8 @@ var (
 // do not block indefinitely (e.g. apply a timeout).
 func (s *Stmt) Close() (err error) {
 	defer func(started time.Time) {
-		s.logger.Log(context.Background(), slog.LevelInfo, s.logger.StmtPrefix+"close", started, err)
+		s.conn.record(EventClose, s, "", nil, err)
+		s.logger.Log(s.ctx, slog.LevelInfo, s.logger.StmtPrefix+"close", started, err)
 	}(time.Time{})
 
 	return s.stmt.Close()
@@ -61,9 +97,13 @@ func (s *Stmt) NumInput() int {
 // as an INSERT or UPDATE.
 //
 // Deprecated: Drivers should implement StmtExecContext instead (or additionally).
-func (s *Stmt) Exec(args []driver.Value) (_ driver.Result, err error) {
+func (s *Stmt) Exec(args []driver.Value) (res driver.Result, err error) {
 	defer func(started time.Time) {
-		s.logger.Log(context.Background(), slog.LevelInfo, s.logger.StmtPrefix+"exec", started, err, logArgs(args))
+		s.conn.recordExec(s, s.query, args, res, err)
+
+		if s.logger.Enabled(s.ctx, slog.LevelInfo, err) {
+			s.logger.Log(s.ctx, slog.LevelInfo, s.logger.StmtPrefix+"exec", started, err, s.logger.logArgs(args))
+		}
 	}(time.Now())
 
 	return s.stmt.Exec(args)
@@ -73,9 +113,19 @@ func (s *Stmt) Exec(args []driver.Value) (_ driver.Result, err error) {
 // as an INSERT or UPDATE.
 //
 // ExecContext must honor the context timeout and return when it is canceled.
-func (s *Stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (_ driver.Result, err error) {
+func (s *Stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (res driver.Result, err error) {
+	s.conn.setContextIDs(ctx)
+
+	ctx, cancel, timeout := s.logger.withTimeout(ctx, OpExec)
+	defer cancel()
+
 	defer func(started time.Time) {
-		s.logger.Log(ctx, slog.LevelInfo, s.logger.StmtPrefix+"execContext", started, err, logArgs(args))
+		s.conn.recordExec(s, s.query, args, res, err)
+
+		if s.logger.Enabled(ctx, slog.LevelInfo, err) {
+			s.logger.Log(ctx, slog.LevelInfo, s.logger.StmtPrefix+"execContext", started, err,
+				s.logger.logArgs(args), logTimeout(timeout, err))
+		}
 	}(time.Now())
 
 	if execer, ok := s.stmt.(driver.StmtExecContext); ok {
@@ -103,10 +153,15 @@ func (s *Stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (_ dri
 // Deprecated: Drivers should implement StmtQueryContext instead (or additionally).
 func (s *Stmt) Query(args []driver.Value) (_ driver.Rows, err error) {
 	defer func(started time.Time) {
-		s.logger.Log(context.Background(), slog.LevelInfo, s.logger.StmtPrefix+"query", started, err, logArgs(args))
+		if s.logger.Enabled(s.ctx, slog.LevelInfo, err) {
+			s.logger.Log(s.ctx, slog.LevelInfo, s.logger.StmtPrefix+"query", started, err, s.logger.logArgs(args))
+		}
 	}(time.Now())
 
-	return s.stmt.Query(args)
+	rows, err := s.stmt.Query(args)
+
+	return s.logger.wrapRows(s.ctx, rows, err, nil,
+		s.conn.recordQuery(s, s.query, args, err))
 }
 
 // QueryContext executes a query that may return rows, such as a
@@ -114,17 +169,49 @@ func (s *Stmt) Query(args []driver.Value) (_ driver.Rows, err error) {
 //
 // QueryContext must honor the context timeout and return when it is canceled.
 func (s *Stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (_ driver.Rows, err error) {
+	s.conn.setContextIDs(ctx)
+
+	ctx, cancel, timeout := s.logger.withTimeout(ctx, OpQuery)
+
+	var attempt int
+
 	defer func(started time.Time) {
-		s.logger.Log(ctx, slog.LevelInfo, s.logger.StmtPrefix+"queryContext", started, err, logArgs(args))
+		if s.logger.Enabled(ctx, slog.LevelInfo, err) {
+			s.logger.Log(ctx, slog.LevelInfo, s.logger.StmtPrefix+"queryContext", started, err,
+				s.logger.logArgs(args), logTimeout(timeout, err), logAttempt(attempt))
+		}
 	}(time.Now())
 
+	var rows driver.Rows
+
+	fn := func() (err error) {
+		rows, err = s.queryContext(ctx, args)
+		return err
+	}
+
+	// only idempotent queries outside transactions are retried
+	if s.logger.Retry.Ops.Has(OpQuery) && s.conn != nil && s.conn.tx == nil && isRetryable(ctx) {
+		attempt, err = s.logger.retry(ctx, OpQuery, s.logger.StmtPrefix+"queryContext", fn)
+	} else {
+		err = fn()
+	}
+
+	if timeout == 0 {
+		cancel = nil
+	}
+
+	return s.logger.wrapRows(ctx, rows, err, cancel,
+		s.conn.recordQuery(s, s.query, args, err))
+}
+
+func (s *Stmt) queryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
 	if query, ok := s.stmt.(driver.StmtQueryContext); ok {
 		return query.QueryContext(ctx, args)
 	}
 
 	// StmtQueryContext.QueryContext is not permitted to return ErrSkip. fall back to Query.
-	var dargs []driver.Value
-	if dargs, err = namedValueToValue(args); err != nil {
+	dargs, err := namedValueToValue(args)
+	if err != nil {
 		return nil, err
 	}
 
@@ -140,11 +227,12 @@ func (s *Stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (_ dr
 // CheckNamedValue is called before passing arguments to the driver
 // and is called in place of any ColumnConverter. CheckNamedValue must do type
 // validation and conversion as appropriate for the driver.
-func (s *Stmt) CheckNamedValue(namedValue *driver.NamedValue) (err error) {
-	namedValueChecker, ok := s.stmt.(driver.NamedValueChecker)
-	if !ok {
-		return driver.ErrSkip
-	}
+func (s stmtNamedValueChecker) CheckNamedValue(namedValue *driver.NamedValue) error {
+	return s.stmt.(driver.NamedValueChecker).CheckNamedValue(namedValue)
+}
 
-	return namedValueChecker.CheckNamedValue(namedValue)
+// ColumnConverter returns a ValueConverter for the provided
+// column index.
+func (s columnConverter) ColumnConverter(idx int) driver.ValueConverter {
+	return s.stmt.(driver.ColumnConverter).ColumnConverter(idx) //nolint:staticcheck // forwarded
 }
@@ -0,0 +1,242 @@
+package internal
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/mdigger/sqlog/sqlogtest/fakedriver"
+)
+
+// prepare returns the statement prepared with the context.
+func prepare(t *testing.T, conn driver.Conn, query string) driver.Stmt {
+	t.Helper()
+
+	stmt, err := conn.(driver.ConnPrepareContext).PrepareContext(context.Background(), query)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return stmt
+}
+
+func TestStmtExec(t *testing.T) {
+	args := []driver.NamedValue{{Ordinal: 1, Value: "alice"}}
+
+	tests := []struct {
+		name       string
+		interfaces fakedriver.Interface
+		exec       func(driver.Stmt) (driver.Result, error)
+		call       string
+		msg        string
+	}{
+		{"context", fakedriver.StmtExecContext, func(s driver.Stmt) (driver.Result, error) {
+			return s.(driver.StmtExecContext).ExecContext(context.Background(), args)
+		}, "Stmt.ExecContext", "stmt:execContext"},
+		{"contextFallback", 0, func(s driver.Stmt) (driver.Result, error) {
+			return s.(driver.StmtExecContext).ExecContext(context.Background(), args)
+		}, "Stmt.Exec", "stmt:execContext"},
+		{"legacy", fakedriver.StmtExecContext, func(s driver.Stmt) (driver.Result, error) {
+			return s.Exec([]driver.Value{"alice"}) //nolint:staticcheck // legacy
+		}, "Stmt.Exec", "stmt:exec"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, d, h := openConn(t, &fakedriver.Options{Interfaces: tt.interfaces},
+				&Config{StmtPrefix: "stmt:"})
+			stmt := prepare(t, conn, "UPDATE users SET name = ?")
+			d.ResetCalls()
+
+			if _, err := tt.exec(stmt); err != nil {
+				t.Fatal(err)
+			}
+
+			if calls := d.Calls(); !slices.Equal(calls, []string{tt.call}) {
+				t.Errorf("calls = %q, want %q", calls, tt.call)
+			}
+
+			_, attrs := h.Last(t, tt.msg)
+			if attrs[stmtIDKey].String() != stmt.(*Stmt).StmtID() {
+				t.Errorf("stmtID = %v, want %v", attrs[stmtIDKey], stmt.(*Stmt).StmtID())
+			}
+
+			if _, ok := attrs["args"]; !ok {
+				t.Error("no args attribute")
+			}
+		})
+	}
+}
+
+func TestStmtQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		interfaces fakedriver.Interface
+		query      func(driver.Stmt) (driver.Rows, error)
+		call       string
+		msg        string
+	}{
+		{"context", fakedriver.StmtQueryContext, func(s driver.Stmt) (driver.Rows, error) {
+			return s.(driver.StmtQueryContext).QueryContext(context.Background(), nil)
+		}, "Stmt.QueryContext", "stmt:queryContext"},
+		{"contextFallback", 0, func(s driver.Stmt) (driver.Rows, error) {
+			return s.(driver.StmtQueryContext).QueryContext(context.Background(), nil)
+		}, "Stmt.Query", "stmt:queryContext"},
+		{"legacy", fakedriver.StmtQueryContext, func(s driver.Stmt) (driver.Rows, error) {
+			return s.Query(nil) //nolint:staticcheck // legacy
+		}, "Stmt.Query", "stmt:query"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, d, h := openConn(t, &fakedriver.Options{
+				Interfaces: tt.interfaces,
+				Rows: func(string, []driver.NamedValue) ([]string, [][]driver.Value) {
+					return []string{"id"}, [][]driver.Value{{int64(1)}}
+				},
+			}, &Config{StmtPrefix: "stmt:"})
+			stmt := prepare(t, conn, "SELECT id FROM users")
+			d.ResetCalls()
+
+			rows, err := tt.query(stmt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			dest := make([]driver.Value, 1)
+			if err := rows.Next(dest); err != nil || dest[0] != int64(1) {
+				t.Errorf("row = %v, %v, want [1]", dest, err)
+			}
+
+			rows.Close()
+
+			if calls := d.Calls(); !slices.Equal(calls, []string{tt.call}) {
+				t.Errorf("calls = %q, want %q", calls, tt.call)
+			}
+
+			h.Last(t, tt.msg)
+		})
+	}
+}
+
+func TestStmtError(t *testing.T) {
+	conn, _, h := openConn(t, &fakedriver.Options{
+		Interfaces: fakedriver.StmtExecContext,
+		Err:        injectErr("Stmt.ExecContext", errInjected),
+	}, nil)
+	stmt := prepare(t, conn, "DELETE FROM users")
+
+	_, err := stmt.(driver.StmtExecContext).ExecContext(context.Background(), nil)
+	if !errors.Is(err, errInjected) {
+		t.Fatalf("error = %v, want %v", err, errInjected)
+	}
+
+	if r, attrs := h.Last(t, "execContext"); r.Level != slog.LevelError || attrs["error"].Any() != errInjected {
+		t.Errorf("unexpected record %v", r)
+	}
+}
+
+func TestStmtTimeout(t *testing.T) {
+	conn, _, h := openConn(t, &fakedriver.Options{
+		Interfaces: fakedriver.ConnPrepareContext | fakedriver.StmtQueryContext,
+		Delay:      100 * time.Millisecond,
+	}, &Config{Timeouts: map[Op]time.Duration{OpQuery: 10 * time.Millisecond}})
+	stmt := prepare(t, conn, "SELECT 1")
+
+	_, err := stmt.(driver.StmtQueryContext).QueryContext(context.Background(), nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if _, attrs := h.Last(t, "queryContext"); attrs["timeout"].Duration() != 10*time.Millisecond {
+		t.Errorf("timeout = %v, want %v", attrs["timeout"], 10*time.Millisecond)
+	}
+}
+
+func TestStmtCanceled(t *testing.T) {
+	conn, d, _ := openConn(t, nil, nil)
+	stmt := prepare(t, conn, "DELETE FROM users")
+	d.ResetCalls()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// the legacy Stmt.Exec has no context, so it is checked before the call
+	_, err := stmt.(driver.StmtExecContext).ExecContext(ctx, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("error = %v, want %v", err, context.Canceled)
+	}
+
+	if calls := d.Calls(); len(calls) != 0 {
+		t.Errorf("calls = %q, want none", calls)
+	}
+}
+
+func TestStmtClose(t *testing.T) {
+	conn, _, h := openConn(t, &fakedriver.Options{Err: injectErr("Stmt.Close", errInjected)},
+		&Config{StmtPrefix: "stmt:"})
+	stmt := prepare(t, conn, "SELECT 1")
+
+	if err := stmt.Close(); !errors.Is(err, errInjected) {
+		t.Fatalf("error = %v, want %v", err, errInjected)
+	}
+
+	if r, _ := h.Last(t, "stmt:close"); r.Level != slog.LevelError {
+		t.Errorf("level = %v, want %v", r.Level, slog.LevelError)
+	}
+}
+
+func TestStmtQueryRetry(t *testing.T) {
+	deadlock := sqlStateError("40P01")
+	retry := ContextWithRetry(context.Background())
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		inTx  bool
+		calls int
+	}{
+		{"deadlock", retry, false, 3},
+		{"notIdempotent", context.Background(), false, 1},
+		{"inTx", retry, true, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, d, h := openConn(t, &fakedriver.Options{
+				Interfaces: fakedriver.ConnPrepareContext | fakedriver.ConnBeginTx | fakedriver.StmtQueryContext,
+				Err:        injectErr("Stmt.QueryContext", deadlock),
+			}, &Config{
+				StmtPrefix:  "stmt:",
+				Classifiers: DefaultClassifiers,
+				Retry:       RetryPolicy{Ops: OpQuery, MaxAttempts: 3},
+			})
+
+			if tt.inTx {
+				if _, err := conn.(driver.ConnBeginTx).BeginTx(context.Background(), driver.TxOptions{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			stmt := prepare(t, conn, "SELECT 1")
+			d.ResetCalls()
+
+			_, err := stmt.(driver.StmtQueryContext).QueryContext(tt.ctx, nil)
+			if !errors.Is(err, deadlock) {
+				t.Fatalf("error = %v, want %v", err, deadlock)
+			}
+
+			if calls := len(d.Calls()); calls != tt.calls {
+				t.Errorf("%d calls, want %d", calls, tt.calls)
+			}
+
+			if _, attrs := h.Last(t, "stmt:queryContext"); tt.calls > 1 && attrs["attempt"].Int64() != int64(tt.calls) {
+				t.Errorf("attempt = %v, want %d", attrs["attempt"], tt.calls)
+			}
+		})
+	}
+}
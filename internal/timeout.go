@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+// timeout returns the default timeout of op.
+func (l Logger) timeout(op Op) time.Duration {
+	if d, ok := l.Timeouts[op]; ok {
+		return d
+	}
+
+	return l.DefaultTimeout
+}
+
+// withTimeout returns a copy of ctx with the default timeout of op,
+// if the ctx has no deadline. The returned timeout is zero,
+// when the context is not changed.
+func (l Logger) withTimeout(ctx context.Context, op Op) (context.Context, context.CancelFunc, time.Duration) {
+	timeout := l.timeout(op)
+	if timeout <= 0 {
+		return ctx, func() {}, 0
+	}
+
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}, 0
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	return ctx, cancel, timeout
+}
+
+// logTimeout returns the timeout attribute, if the operation failed
+// with the exceeded default timeout.
+func logTimeout(timeout time.Duration, err error) slog.Attr {
+	if timeout == 0 || !errors.Is(err, context.DeadlineExceeded) {
+		return slog.Attr{}
+	}
+
+	return slog.Duration("timeout", timeout)
+}
@@ -2,9 +2,14 @@ package internal
 
 import (
 	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
-
-	"golang.org/x/exp/slog"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Copied from stdlib database/sql package: src/database/sql/ctxutil.go.
@@ -23,18 +28,153 @@ func logQuery(query string) slog.Attr {
 	return slog.String("query", query)
 }
 
-func logArgs(args any) slog.Attr {
-	var dargs []driver.Value
+// BytesFormat is the format of logged []byte arguments.
+type BytesFormat int
+
+const (
+	BytesRaw    BytesFormat = iota // as is, truncated to the maximum length
+	BytesLength                    // only the length
+	BytesHex                       // hex encoded prefix
+	BytesBase64                    // base64 encoded prefix
+)
+
+// ArgsPolicy describes the formatting of logged query arguments.
+type ArgsPolicy struct {
+	MaxCount     int         // maximum number of logged arguments, if not zero
+	MaxStringLen int         // maximum length of string and []byte values, if not zero
+	Bytes        BytesFormat // format of []byte values
+}
+
+// logArgs returns the attribute of the query arguments, formatted only
+// when the handler renders it.
+func (l Logger) logArgs(args any) slog.Attr {
+	value := argsValue{policy: l.Args, redact: l.Redact}
+
 	switch args := args.(type) {
-	case nil:
 	case []driver.NamedValue:
-		dargs = make([]driver.Value, len(args))
-		for n, param := range args {
-			dargs[n] = param.Value
-		}
+		value.named = args
 	case []driver.Value:
-		dargs = args
+		value.values = args
+	}
+
+	return slog.Any("args", value)
+}
+
+// argsValue is the slog.LogValuer formatting the query arguments.
+type argsValue struct {
+	named  []driver.NamedValue
+	values []driver.Value
+	policy ArgsPolicy
+	redact Redactor
+}
+
+var _ slog.LogValuer = argsValue{}
+
+// LogValue returns the formatted arguments.
+func (a argsValue) LogValue() slog.Value {
+	count := len(a.values) + len(a.named)
+
+	limit := count
+	if a.policy.MaxCount > 0 && limit > a.policy.MaxCount {
+		limit = a.policy.MaxCount
+	}
+
+	dargs := make([]driver.Value, 0, limit+1)
+
+	for i := 0; i < limit; i++ {
+		var (
+			name  string
+			value driver.Value
+		)
+
+		if a.named != nil {
+			name, value = a.named[i].Name, a.named[i].Value
+			if name == "" {
+				name = strconv.Itoa(a.named[i].Ordinal)
+			}
+		} else {
+			name, value = strconv.Itoa(i+1), a.values[i]
+		}
+
+		if a.redact != nil {
+			value = a.redact(name, value)
+		}
+
+		dargs = append(dargs, a.policy.format(value))
+	}
+
+	if limit < count {
+		dargs = append(dargs, fmt.Sprintf("…+%d", count-limit))
+	}
+
+	return slog.AnyValue(dargs)
+}
+
+// format returns the argument value formatted by the policy.
+func (p ArgsPolicy) format(value driver.Value) driver.Value {
+	switch v := value.(type) {
+	case string:
+		return truncateString(v, p.MaxStringLen)
+	case []byte:
+		return p.formatBytes(v)
+	default:
+		return value
+	}
+}
+
+func (p ArgsPolicy) formatBytes(v []byte) driver.Value {
+	prefix, suffix := v, ""
+	if p.MaxStringLen > 0 && len(v) > p.MaxStringLen {
+		prefix, suffix = v[:p.MaxStringLen], "…"
+	}
+
+	switch p.Bytes {
+	case BytesLength:
+		return fmt.Sprintf("[%d bytes]", len(v))
+	case BytesHex:
+		return hex.EncodeToString(prefix) + suffix
+	case BytesBase64:
+		return base64.StdEncoding.EncodeToString(prefix) + suffix
+	default:
+		return append([]byte(nil), prefix...) // not the memory of the caller
+	}
+}
+
+// QueryPolicy describes the formatting of logged queries.
+type QueryPolicy struct {
+	Compact     bool          // collapse whitespace runs into single spaces
+	MaxLen      int           // maximum length of the query, if not zero
+	FullOnError bool          // log the full query of failed operations
+	FullOnSlow  time.Duration // log the full query of operations slower than it, if not zero
+}
+
+// format formats the query attribute in place and appends the truncation
+// markers. The full flag disables the truncation.
+func (p QueryPolicy) format(attrs []slog.Attr, full bool) []slog.Attr {
+	if !p.Compact && p.MaxLen <= 0 {
+		return attrs
+	}
+
+	for i, attr := range attrs {
+		if attr.Key != "query" || attr.Value.Kind() != slog.KindString {
+			continue
+		}
+
+		query := attr.Value.String()
+		if p.Compact {
+			query = strings.Join(strings.Fields(query), " ")
+		}
+
+		if !full && p.MaxLen > 0 && len(query) > p.MaxLen {
+			attrs = append(attrs,
+				slog.Bool("queryTruncated", true), slog.Int("queryLength", len(query)))
+			query = truncateString(query, p.MaxLen)
+		}
+
+		attrs[i] = slog.String("query", query)
+
+		break
 	}
 
-	return slog.Any("args", dargs)
+	return attrs
 }
@@ -0,0 +1,150 @@
+package internal
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"log/slog"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestArgsValue(t *testing.T) {
+	data := []byte("binary data")
+	redact := func(name string, value driver.Value) driver.Value {
+		if name == "password" || name == "2" {
+			return "***"
+		}
+
+		return value
+	}
+
+	tests := []struct {
+		name  string
+		value argsValue
+		want  []driver.Value
+	}{
+		{"values", argsValue{values: []driver.Value{int64(1), "alice", nil}},
+			[]driver.Value{int64(1), "alice", nil}},
+		{"maxCount", argsValue{values: []driver.Value{int64(1), int64(2), int64(3), int64(4)}, policy: ArgsPolicy{MaxCount: 2}},
+			[]driver.Value{int64(1), int64(2), "…+2"}},
+		{"maxCountNamed", argsValue{named: []driver.NamedValue{{Ordinal: 1, Value: "a"}, {Ordinal: 2, Value: "b"}}, policy: ArgsPolicy{MaxCount: 1}},
+			[]driver.Value{"a", "…+1"}},
+		{"string", argsValue{values: []driver.Value{"alice", "привет"}, policy: ArgsPolicy{MaxStringLen: 3}},
+			[]driver.Value{"ali…", "п…"}},
+		{"raw", argsValue{values: []driver.Value{data}, policy: ArgsPolicy{MaxStringLen: 6}},
+			[]driver.Value{[]byte("binary")}},
+		{"rawShort", argsValue{values: []driver.Value{data}},
+			[]driver.Value{data}},
+		{"length", argsValue{values: []driver.Value{data}, policy: ArgsPolicy{Bytes: BytesLength, MaxStringLen: 6}},
+			[]driver.Value{"[11 bytes]"}},
+		{"hex", argsValue{values: []driver.Value{data}, policy: ArgsPolicy{Bytes: BytesHex, MaxStringLen: 3}},
+			[]driver.Value{"62696e…"}},
+		{"base64", argsValue{values: []driver.Value{data}, policy: ArgsPolicy{Bytes: BytesBase64}},
+			[]driver.Value{"YmluYXJ5IGRhdGE="}},
+		{"redactNamed", argsValue{named: []driver.NamedValue{{Name: "login", Value: "alice"}, {Name: "password", Value: "secret"}}, redact: redact},
+			[]driver.Value{"alice", "***"}},
+		{"redactOrdinal", argsValue{named: []driver.NamedValue{{Ordinal: 1, Value: "alice"}, {Ordinal: 2, Value: "secret"}}, redact: redact},
+			[]driver.Value{"alice", "***"}},
+		{"redactValues", argsValue{values: []driver.Value{"alice", "secret"}, redact: redact},
+			[]driver.Value{"alice", "***"}},
+		{"redactBeforeFormat", argsValue{values: []driver.Value{"alice", "secret"}, redact: redact, policy: ArgsPolicy{MaxStringLen: 2}},
+			[]driver.Value{"al…", "**…"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.LogValue().Any(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LogValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArgsValueCopy(t *testing.T) {
+	data := []byte("data")
+	value := argsValue{values: []driver.Value{data}}.LogValue()
+
+	copy(data, "xxxx")
+
+	if got := value.Any().([]driver.Value)[0]; string(got.([]byte)) != "data" {
+		t.Errorf("logged bytes = %q, want the copy of the argument", got)
+	}
+}
+
+func TestQueryPolicyFormat(t *testing.T) {
+	query := "SELECT id,\n\t\tname\n  FROM users"
+
+	tests := []struct {
+		name   string
+		policy QueryPolicy
+		full   bool
+		want   map[string]any
+	}{
+		{"none", QueryPolicy{}, false,
+			map[string]any{"query": query}},
+		{"compact", QueryPolicy{Compact: true}, false,
+			map[string]any{"query": "SELECT id, name FROM users"}},
+		{"truncated", QueryPolicy{MaxLen: 9}, false,
+			map[string]any{"query": "SELECT id…", "queryTruncated": true, "queryLength": int64(len(query))}},
+		{"compactTruncated", QueryPolicy{Compact: true, MaxLen: 15}, false,
+			map[string]any{"query": "SELECT id, name…", "queryTruncated": true, "queryLength": int64(26)}},
+		{"short", QueryPolicy{Compact: true, MaxLen: 26}, false,
+			map[string]any{"query": "SELECT id, name FROM users"}},
+		{"full", QueryPolicy{Compact: true, MaxLen: 9}, true,
+			map[string]any{"query": "SELECT id, name FROM users"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := tt.policy.format([]slog.Attr{slog.Int("id", 1), logQuery(query)}, tt.full)
+
+			got := make(map[string]any, len(attrs))
+			for _, attr := range attrs[1:] {
+				got[attr.Key] = attr.Value.Any()
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryPolicyFull(t *testing.T) {
+	failed := errors.New("failed")
+	query := "SELECT id, name FROM users"
+
+	tests := []struct {
+		name    string
+		policy  QueryPolicy
+		started time.Time
+		err     error
+		want    string
+	}{
+		{"truncated", QueryPolicy{MaxLen: 9, FullOnError: true, FullOnSlow: time.Hour}, time.Now(), nil, "SELECT id…"},
+		{"error", QueryPolicy{MaxLen: 9, FullOnError: true}, time.Now(), failed, query},
+		{"errorDisabled", QueryPolicy{MaxLen: 9}, time.Now(), failed, "SELECT id…"},
+		{"slow", QueryPolicy{MaxLen: 9, FullOnSlow: time.Second}, time.Now().Add(-time.Minute), nil, query},
+		{"notStarted", QueryPolicy{MaxLen: 9, FullOnSlow: time.Second}, time.Time{}, nil, "SELECT id…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := new(recordHandler)
+			logger := Logger{Handler: h, Config: &Config{Query: tt.policy}}
+
+			logger.Log(context.Background(), slog.LevelInfo, "queryContext", tt.started, tt.err, logQuery(query))
+
+			_, attrs := h.Last(t, "queryContext")
+			if got := attrs["query"].String(); got != tt.want {
+				t.Errorf("query = %q, want %q", got, tt.want)
+			}
+
+			if _, truncated := attrs["queryTruncated"]; truncated != (tt.want != query) {
+				t.Errorf("queryTruncated = %v, want %v", truncated, tt.want != query)
+			}
+		})
+	}
+}
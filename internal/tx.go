@@ -3,9 +3,8 @@ package internal
 import (
 	"context"
 	"database/sql/driver"
+	"log/slog"
 	"time"
-
-	"golang.org/x/exp/slog"
 )
 
 var _ driver.Tx = (*Tx)(nil)
@@ -15,27 +14,64 @@ type Tx struct {
 	tx      driver.Tx
 	started time.Time
 	logger  Logger
+	conn    *Conn           // connection of the transaction, if known
+	ctx     context.Context // logging overrides of the beginning
+	id      lazyID
 }
 
 func NewTx(tx driver.Tx, logger Logger) *Tx {
-	return &Tx{
+	t := &Tx{
 		tx:      tx,
 		started: time.Now(),
 		logger:  logger,
+		ctx:     context.Background(),
+		id:      lazyID{key: txIDKey},
 	}
+	t.logger.id = &t.id
+
+	return t
+}
+
+// logID returns the transaction identifier attribute.
+// The nil transaction, failed to begin, has a new identifier.
+func (t *Tx) logID(newID func() string) slog.Attr {
+	if t == nil {
+		return slog.String(txIDKey, newID())
+	}
+
+	return t.id.attr()
+}
+
+// Unwrap returns the driver transaction.
+func (t *Tx) Unwrap() driver.Tx {
+	return t.tx
 }
 
 func (t *Tx) Commit() (err error) {
+	defer t.done()
+
 	defer func() {
-		t.logger.Log(context.Background(), slog.LevelInfo, t.logger.TxPrefix+"commit", t.started, err)
+		t.conn.record(EventCommit, nil, "", nil, err)
+		t.logger.Log(t.ctx, slog.LevelInfo, t.logger.TxPrefix+"commit", t.started, err)
 	}()
 
 	return t.tx.Commit()
 }
 
 func (t *Tx) Rollback() (err error) {
+	defer t.done()
+
 	defer func() {
-		t.logger.Log(context.Background(), slog.LevelInfo, t.logger.TxPrefix+"rollback", t.started, err)
+		t.conn.record(EventRollback, nil, "", nil, err)
+		t.logger.Log(t.ctx, slog.LevelInfo, t.logger.TxPrefix+"rollback", t.started, err)
 	}()
+
 	return t.tx.Rollback()
 }
+
+// done marks the transaction of the connection as finished.
+func (t *Tx) done() {
+	if t.conn != nil && t.conn.tx == t {
+		t.conn.tx = nil
+	}
+}
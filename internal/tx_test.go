@@ -0,0 +1,159 @@
+package internal
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/mdigger/sqlog/sqlogtest/fakedriver"
+)
+
+func TestConnBegin(t *testing.T) {
+	tests := []struct {
+		name       string
+		interfaces fakedriver.Interface
+		begin      func(driver.Conn) (driver.Tx, error)
+		call       string
+		msg        string
+	}{
+		{"context", fakedriver.ConnBeginTx, func(c driver.Conn) (driver.Tx, error) {
+			return c.(driver.ConnBeginTx).BeginTx(context.Background(), driver.TxOptions{})
+		}, "Conn.BeginTx", "beginTx"},
+		{"contextFallback", 0, func(c driver.Conn) (driver.Tx, error) {
+			return c.(driver.ConnBeginTx).BeginTx(context.Background(), driver.TxOptions{})
+		}, "Conn.Begin", "beginTx"},
+		{"legacy", fakedriver.ConnBeginTx, func(c driver.Conn) (driver.Tx, error) {
+			return c.Begin() //nolint:staticcheck // legacy
+		}, "Conn.Begin", "begin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, d, h := openConn(t, &fakedriver.Options{Interfaces: tt.interfaces},
+				&Config{TxPrefix: "tx:"})
+
+			tx, err := tt.begin(conn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			txID := conn.(interface{ TxID() string }).TxID()
+			if txID == "" || txID != tx.(*Tx).TxID() {
+				t.Errorf("connection txID = %q, want %q", txID, tx.(*Tx).TxID())
+			}
+
+			if _, attrs := h.Last(t, tt.msg); attrs[txIDKey].String() != txID {
+				t.Errorf("txID = %v, want %v", attrs[txIDKey], txID)
+			}
+
+			if err := tx.Commit(); err != nil {
+				t.Fatal(err)
+			}
+
+			if calls := d.Calls(); !slices.Equal(calls, []string{tt.call, "Tx.Commit"}) {
+				t.Errorf("calls = %q, want %q", calls, []string{tt.call, "Tx.Commit"})
+			}
+
+			if _, attrs := h.Last(t, "tx:commit"); attrs[txIDKey].String() != txID {
+				t.Errorf("txID = %v, want %v", attrs[txIDKey], txID)
+			}
+
+			if id := conn.(interface{ TxID() string }).TxID(); id != "" {
+				t.Errorf("connection txID = %q after commit, want none", id)
+			}
+		})
+	}
+}
+
+func TestConnBeginFallbackOptions(t *testing.T) {
+	conn, d, _ := openConn(t, nil, nil)
+
+	for _, opts := range []driver.TxOptions{
+		{ReadOnly: true},
+		{Isolation: driver.IsolationLevel(sql.LevelSerializable)},
+	} {
+		if _, err := conn.(driver.ConnBeginTx).BeginTx(context.Background(), opts); err == nil {
+			t.Errorf("BeginTx(%+v) succeeded without the driver support", opts)
+		}
+	}
+
+	if calls := d.Calls(); len(calls) != 0 {
+		t.Errorf("calls = %q, want none", calls)
+	}
+}
+
+func TestConnBeginCanceled(t *testing.T) {
+	conn, d, h := openConn(t, nil, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// the legacy Begin has no context, so the transaction is rolled back
+	_, err := conn.(driver.ConnBeginTx).BeginTx(ctx, driver.TxOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("error = %v, want %v", err, context.Canceled)
+	}
+
+	if calls := d.Calls(); !slices.Equal(calls, []string{"Conn.Begin", "Tx.Rollback"}) {
+		t.Errorf("calls = %q, want %q", calls, []string{"Conn.Begin", "Tx.Rollback"})
+	}
+
+	if r, _ := h.Last(t, "beginTx"); r.Level != slog.LevelError {
+		t.Errorf("level = %v, want %v", r.Level, slog.LevelError)
+	}
+}
+
+func TestConnBeginError(t *testing.T) {
+	conn, _, h := openConn(t, &fakedriver.Options{
+		Interfaces: fakedriver.ConnBeginTx,
+		Err:        injectErr("Conn.BeginTx", errInjected),
+	}, nil)
+
+	tx, err := conn.(driver.ConnBeginTx).BeginTx(context.Background(), driver.TxOptions{})
+	if !errors.Is(err, errInjected) || tx != nil {
+		t.Fatalf("begin = %v, %v, want the error %v", tx, err, errInjected)
+	}
+
+	// the failed transaction has the own identifier in the log
+	if _, attrs := h.Last(t, "beginTx"); attrs[txIDKey].String() == "" {
+		t.Error("no txID of the failed transaction")
+	}
+}
+
+func TestTxError(t *testing.T) {
+	for _, call := range []string{"Tx.Commit", "Tx.Rollback"} {
+		t.Run(call, func(t *testing.T) {
+			conn, _, h := openConn(t, &fakedriver.Options{
+				Interfaces: fakedriver.ConnBeginTx,
+				Err:        injectErr(call, errInjected),
+			}, &Config{TxPrefix: "tx:"})
+
+			tx, err := conn.(driver.ConnBeginTx).BeginTx(context.Background(), driver.TxOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			end, msg := tx.Commit, "tx:commit"
+			if call == "Tx.Rollback" {
+				end, msg = tx.Rollback, "tx:rollback"
+			}
+
+			if err := end(); !errors.Is(err, errInjected) {
+				t.Fatalf("error = %v, want %v", err, errInjected)
+			}
+
+			if r, attrs := h.Last(t, msg); r.Level != slog.LevelError || attrs["error"].Any() != errInjected {
+				t.Errorf("unexpected record %v", r)
+			}
+
+			// the failed transaction is finished anyway
+			if id := conn.(interface{ TxID() string }).TxID(); id != "" {
+				t.Errorf("connection txID = %q, want none", id)
+			}
+		})
+	}
+}
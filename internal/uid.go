@@ -1,25 +1,157 @@
 package internal
 
 import (
+	"context"
 	"crypto/rand"
+	"encoding/binary"
 	"fmt"
+	"log/slog"
+	"strconv"
+	"sync/atomic"
+	"time"
 )
 
 const (
-	defaultUIDLen      = 8
+	defaultUIDLen      = 12
 	defaultUIDCharlist = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+	// uidMaxByte excludes the random bytes skewing the distribution
+	// of the characters: 248 is the largest multiple of 62 below 256.
+	uidMaxByte = 256 / len(defaultUIDCharlist) * len(defaultUIDCharlist)
 )
 
-// NewUID generate default 8 byte unique id using math/rand.
+// NewUID generates the default 12 characters random identifier using
+// crypto/rand: about 71 random bits, so the collisions are unlikely even
+// across the millions of statements of long-running processes.
+// All characters of the list are equally probable.
 func NewUID() string {
-	var uid [defaultUIDLen]byte
-	if _, err := rand.Read(uid[:]); err != nil {
-		panic(fmt.Errorf("sqlog: random read error from math/rand: %w", err))
-	}
+	var (
+		uid [defaultUIDLen]byte
+		buf [defaultUIDLen * 2]byte
+	)
+
+	for n := 0; n < defaultUIDLen; {
+		randomRead(buf[:])
 
-	for i := 0; i < defaultUIDLen; i++ {
-		uid[i] = defaultUIDCharlist[uid[i]&byte(len(defaultUIDCharlist)-1)]
+		for _, b := range buf {
+			if int(b) >= uidMaxByte {
+				continue // rejected to avoid the modulo bias
+			}
+
+			uid[n] = defaultUIDCharlist[int(b)%len(defaultUIDCharlist)]
+			n++
+
+			if n == defaultUIDLen {
+				break
+			}
+		}
 	}
 
 	return string(uid[:])
 }
+
+var sequence atomic.Uint64
+
+// SequentialID returns the next identifier of the per-process sequence:
+// "1", "2", "3" and so on.
+func SequentialID() string {
+	return strconv.FormatUint(sequence.Add(1), 10)
+}
+
+// crockford is the Crockford's Base32 alphabet of ULIDs.
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// NewULID returns the new ULID: 26 characters lexicographically sortable
+// by the creation time in milliseconds, with 80 random bits.
+func NewULID() string {
+	var id [16]byte
+
+	binary.BigEndian.PutUint64(id[:8], uint64(time.Now().UnixMilli())<<16)
+	randomRead(id[6:])
+
+	// 128 bits are encoded as 26 characters of 5 bits, the first has 3 bits.
+	hi := binary.BigEndian.Uint64(id[:8])
+	lo := binary.BigEndian.Uint64(id[8:])
+
+	var ulid [26]byte
+	for i := len(ulid) - 1; i >= 0; i-- {
+		ulid[i] = crockford[lo&31]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+
+	return string(ulid[:])
+}
+
+func randomRead(b []byte) {
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Errorf("sqlog: random read error from crypto/rand: %w", err))
+	}
+}
+
+// newID returns the new identifier of the configured generator or NewUID.
+// Connections are pooled and outlive the operation opening them,
+// so their identifiers are never prefixed with the trace.
+func (l Logger) newID() string {
+	return l.generator()()
+}
+
+// generator returns the configured generator or NewUID.
+func (l Logger) generator() func() string {
+	if l.NewID != nil {
+		return l.NewID
+	}
+
+	return NewUID
+}
+
+// idGenerator returns the generator of the statement and transaction
+// identifiers of the operation with the context: the configured generator
+// or NewUID, prefixed with the trace identifier, if configured and present.
+func (l Logger) idGenerator(ctx context.Context) func() string {
+	newID := l.generator()
+
+	if l.TraceID == nil {
+		return newID
+	}
+
+	trace := l.TraceID(ctx)
+	if trace == "" {
+		return newID
+	}
+
+	return func() string { return trace + "-" + newID() }
+}
+
+// Attribute keys of the identifiers.
+const (
+	connIDKey = "connID"
+	stmtIDKey = "stmtID"
+	txIDKey   = "txID"
+)
+
+// lazyID is the identifier generated on first use, so it costs nothing
+// while the logging is disabled.
+type lazyID struct {
+	key   string
+	id    string
+	newID func() string // generator of the identifier, NewUID if nil
+}
+
+// get returns the identifier, generating it on first use.
+func (l *lazyID) get() string {
+	if l.id == "" {
+		if l.newID != nil {
+			l.id = l.newID()
+		} else {
+			l.id = NewUID()
+		}
+	}
+
+	return l.id
+}
+
+// attr returns the identifier attribute.
+func (l *lazyID) attr() slog.Attr {
+	return slog.String(l.key, l.get())
+}
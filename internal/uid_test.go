@@ -0,0 +1,53 @@
+package internal
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestNewUID(t *testing.T) {
+	seen := make(map[string]bool)
+
+	for i := 0; i < 1000; i++ {
+		uid := NewUID()
+		if len(uid) != defaultUIDLen {
+			t.Fatalf("len(%q) = %d, want %d", uid, len(uid), defaultUIDLen)
+		}
+
+		if strings.Trim(uid, defaultUIDCharlist) != "" {
+			t.Fatalf("%q has characters out of the list", uid)
+		}
+
+		if seen[uid] {
+			t.Fatalf("duplicate identifier %q", uid)
+		}
+
+		seen[uid] = true
+	}
+}
+
+func TestTraceIDs(t *testing.T) {
+	type traceKey struct{}
+
+	logger := Logger{Config: &Config{
+		NewID: func() string { return "id" },
+		TraceID: func(ctx context.Context) string {
+			trace, _ := ctx.Value(traceKey{}).(string)
+			return trace
+		},
+	}}
+	ctx := context.WithValue(context.Background(), traceKey{}, "trace")
+
+	if id := logger.newID(); id != "id" {
+		t.Errorf("connection id = %q, want %q", id, "id")
+	}
+
+	if id := logger.idGenerator(ctx)(); id != "trace-id" {
+		t.Errorf("traced id = %q, want %q", id, "trace-id")
+	}
+
+	if id := logger.idGenerator(context.Background())(); id != "id" {
+		t.Errorf("untraced id = %q, want %q", id, "id")
+	}
+}
@@ -0,0 +1,18 @@
+package sqlog
+
+import "github.com/mdigger/sqlog/internal"
+
+// Op is a set of logged SQL operations, used to enable optional
+// features only for some of them.
+type Op = internal.Op
+
+// Supported operations.
+const (
+	OpConnect = internal.OpConnect // connect to the database
+	OpPing    = internal.OpPing    // ping connection
+	OpExec    = internal.OpExec    // execute a query without rows
+	OpQuery   = internal.OpQuery   // execute a query with rows
+	OpPrepare = internal.OpPrepare // prepare a statement
+	OpBegin   = internal.OpBegin   // begin a transaction
+	OpAll     = internal.OpAll     // all operations
+)
@@ -1,7 +1,11 @@
 package sqlog
 
 import (
-	"golang.org/x/exp/slog"
+	"context"
+	"database/sql/driver"
+	"io"
+	"log/slog"
+	"time"
 
 	"github.com/mdigger/sqlog/internal"
 )
@@ -14,7 +18,19 @@ type Options interface {
 // WithLogger set the logger.
 func WithLogger(log *slog.Logger) Options {
 	return option{func(cfg *internal.Logger) {
-		cfg.Logger = log
+		cfg.Handler = nil
+		if log != nil {
+			cfg.Handler = log.Handler()
+		}
+	}}
+}
+
+// WithHandler set the log handler. The records are built directly with
+// the source of the application call site and their attributes are
+// computed only if the handler is enabled for the level.
+func WithHandler(h slog.Handler) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.Handler = h
 	}}
 }
 
@@ -60,20 +76,287 @@ func WithWarnErrSkip() Options {
 	}}
 }
 
+// WithSQLComment enables appending of sqlcommenter-style comments
+// /*key='value',...*/ to the queries of ops operations (OpExec, OpQuery
+// and OpPrepare), so the database side can attribute them.
+// Tags are given as key/value pairs and are added to each comment,
+// for example: WithSQLComment(OpAll, "application", "billing").
+// Zero ops disables the comments.
+func WithSQLComment(ops Op, keyValues ...string) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.Comment.Ops = ops
+
+		if cfg.Comment.Tags == nil && len(keyValues) > 1 {
+			cfg.Comment.Tags = make(map[string]string, len(keyValues)/2)
+		}
+
+		for i := 0; i+1 < len(keyValues); i += 2 {
+			cfg.Comment.Tags[keyValues[i]] = keyValues[i+1]
+		}
+	}}
+}
+
+// WithSQLCommentFunc set the function returning the comment tags from
+// the context, for example the traceparent of the current span.
+func WithSQLCommentFunc(f func(ctx context.Context) map[string]string) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.Comment.Func = f
+	}}
+}
+
+// WithDefaultTimeout set the default timeout of statements executed with
+// a context without deadline. A statement failed with the exceeded timeout
+// is logged with the timeout attribute.
+func WithDefaultTimeout(d time.Duration) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.DefaultTimeout = d
+	}}
+}
+
+// WithTimeout set the default timeout of ops operations, overriding the
+// value of WithDefaultTimeout. Only OpPing, OpExec, OpQuery and OpPrepare
+// operations support timeouts. Zero duration disables the timeout.
+func WithTimeout(ops Op, d time.Duration) Options {
+	return option{func(cfg *internal.Logger) {
+		if cfg.Timeouts == nil {
+			cfg.Timeouts = make(map[Op]time.Duration)
+		}
+
+		for op := Op(1); op <= ops; op <<= 1 {
+			if ops.Has(op) {
+				cfg.Timeouts[op] = d
+			}
+		}
+	}}
+}
+
+// WithErrorClassifier adds the error classifiers. They are tried in order,
+// after the classifiers added before and before the built-in
+// ClassifyContext, ClassifyBadConn and ClassifySQLState.
+func WithErrorClassifier(c ...Classifier) Options {
+	return option{func(cfg *internal.Logger) {
+		custom := len(cfg.Classifiers) - len(internal.DefaultClassifiers) // built-ins are the last
+
+		classifiers := make([]Classifier, 0, len(cfg.Classifiers)+len(c))
+		classifiers = append(classifiers, cfg.Classifiers[:custom]...)
+		classifiers = append(classifiers, c...)
+		cfg.Classifiers = append(classifiers, cfg.Classifiers[custom:]...)
+	}}
+}
+
+// LevelDiscard returned by the WithErrorLevel function drops the record.
+const LevelDiscard = internal.LevelDiscard
+
+// WithErrorLevel adds the function, which returns the level of records
+// logged with err, such as Debug for expected context.Canceled errors.
+// It returns false to leave the level to the next function or to default
+// mapping: LevelError, or dropped driver.ErrSkip (see WithWarnErrSkip).
+// The LevelDiscard level drops the record.
+func WithErrorLevel(f func(err error) (slog.Level, bool)) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.ErrorLevels = append(cfg.ErrorLevels[:len(cfg.ErrorLevels):len(cfg.ErrorLevels)], f)
+	}}
+}
+
+// RetryPolicy describes the retrying of transient failures.
+type RetryPolicy = internal.RetryPolicy
+
+// WithRetry enables the retrying of transient failures of idempotent
+// operations outside transactions: OpConnect, OpPing and OpQuery,
+// also of the prepared statement, with the context returned by
+// ContextWithRetry. By default, the errors of ErrorClassConnection,
+// ErrorClassDeadlock and ErrorClassSerialization classes are transient
+// for OpConnect. OpPing and OpQuery are retried on the same connection,
+// so only deadlocks and serialization failures are retried there and
+// driver.ErrBadConn is left to database/sql, which retries it on another
+// connection. Each failed attempt is logged as a warning and the final
+// outcome has the attempt attribute.
+func WithRetry(policy RetryPolicy) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.Retry = policy
+	}}
+}
+
+// ErrCircuitOpen is returned on connect while the circuit breaker is open.
+var ErrCircuitOpen = internal.ErrCircuitOpen
+
+// WithCircuitBreaker enables the circuit breaker of connections. It opens
+// after threshold consecutive connect failures and fails fast with
+// ErrCircuitOpen for the coolDown duration, then half-opens to allow
+// a single probe. Only the state transitions are logged.
+func WithCircuitBreaker(threshold int, coolDown time.Duration) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.Breaker = internal.BreakerPolicy{
+			Threshold: threshold,
+			CoolDown:  coolDown,
+		}
+	}}
+}
+
+// WithDedup enables the deduplication of error records. Identical records
+// of the same operation, query and error within the window are collapsed.
+// The queries are compared by their fingerprints, so the queries differing
+// only in literals, comments or whitespace are the same:
+// the first one is logged immediately and the last one is logged with
+// the repeated attribute, containing the count of repeats, when the window
+// closes.
+func WithDedup(window time.Duration) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.Dedup = nil
+		if window > 0 {
+			cfg.Dedup = internal.NewDedup(window)
+		}
+	}}
+}
+
+// WithRowsPreview enables the preview of query results in debug mode.
+// The first rows of each result are recorded and logged at debug level
+// as the preview attribute, when the rows are closed. The string and
+// []byte values are truncated to maxValueLen bytes, 64 by default.
+// The redaction policy of WithRedact is applied to the values.
+func WithRowsPreview(rows, maxValueLen int) Options {
+	if maxValueLen <= 0 {
+		maxValueLen = 64
+	}
+
+	return option{func(cfg *internal.Logger) {
+		cfg.Preview = internal.PreviewPolicy{
+			Rows:        rows,
+			MaxValueLen: maxValueLen,
+		}
+	}}
+}
+
+// WithRedact set the redaction policy: the function returning the logged
+// value of the named column or query argument, such as a masked password.
+// Arguments without names are named by their ordinal positions from 1.
+func WithRedact(redact func(name string, value driver.Value) driver.Value) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.Redact = redact
+	}}
+}
+
+// BytesFormat is the format of logged []byte arguments.
+type BytesFormat = internal.BytesFormat
+
+// Supported formats of []byte arguments.
+const (
+	BytesRaw    = internal.BytesRaw    // as is, truncated to the maximum length
+	BytesLength = internal.BytesLength // only the length
+	BytesHex    = internal.BytesHex    // hex encoded prefix
+	BytesBase64 = internal.BytesBase64 // base64 encoded prefix
+)
+
+// WithArgsLimit limits the logged query arguments to maxCount values and
+// string or []byte values to maxStringLen bytes. Zero disables the limit.
+func WithArgsLimit(maxCount, maxStringLen int) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.Args.MaxCount = maxCount
+		cfg.Args.MaxStringLen = maxStringLen
+	}}
+}
+
+// WithBytesFormat set the format of []byte query arguments.
+func WithBytesFormat(format BytesFormat) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.Args.Bytes = format
+	}}
+}
+
+// WithCompactQuery enables the collapsing of whitespace runs in logged
+// queries into single spaces.
+func WithCompactQuery() Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.Query.Compact = true
+	}}
+}
+
+// WithQueryLimit limits the logged queries to maxLen bytes. The truncated
+// query is logged with the queryTruncated=true and queryLength attributes.
+// Zero disables the limit.
+func WithQueryLimit(maxLen int) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.Query.MaxLen = maxLen
+	}}
+}
+
+// WithFullQueryOn disables the query limit for failed operations,
+// if onError is set, and for operations slower than the slow duration,
+// if it is not zero.
+func WithFullQueryOn(onError bool, slow time.Duration) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.Query.FullOnError = onError
+		cfg.Query.FullOnSlow = slow
+	}}
+}
+
+// WithRecorder records the driver calls of the session (connections,
+// prepared statements, queries with their arguments and rows, results and
+// transactions) to w as the versioned JSON Lines. The writes are serialized.
+// The recording stops after the first write error, which is logged once
+// with the "record" message.
+func WithRecorder(w io.Writer) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.Recorder = internal.NewRecorder(w)
+	}}
+}
+
+// WithIDGenerator sets the generator of the connection, statement and
+// transaction identifiers: RandomID by default, SequentialID, ULID or custom.
+func WithIDGenerator(newID func() string) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.NewID = newID
+	}}
+}
+
+// WithTraceIDs derives the statement and transaction identifiers from
+// the trace of the operation: the trace identifier returned by traceID
+// for the context is prefixed to the generated identifier, e.g.
+// "4bf92f3577b34da6a3ce929d0e0e4736-a1B2c3D4e5F6". The connection
+// identifiers are not prefixed, as the pooled connections outlive the trace
+// opening them. The identifiers of the operations without a trace are
+// not changed.
+func WithTraceIDs(traceID func(ctx context.Context) string) Options {
+	return option{func(cfg *internal.Logger) {
+		cfg.TraceID = traceID
+	}}
+}
+
+// RandomID returns the random identifier of 12 alphanumeric characters,
+// the default one.
+func RandomID() string { return internal.NewUID() }
+
+// SequentialID returns the next identifier of the per-process sequence:
+// "1", "2", "3" and so on. It is readable in development.
+func SequentialID() string { return internal.SequentialID() }
+
+// ULID returns the new ULID: the random identifier of 26 characters
+// sortable by its creation time.
+func ULID() string { return internal.NewULID() }
+
 func newDefaultLogger(opt ...Options) internal.Logger {
 	logger := internal.Logger{
-		Logger:       slog.Default(),
-		BasePrefix:   "sql:",
-		StmtPrefix:   "stmt:",
-		TxPrefix:     "tx:",
-		WithDuration: true,
-		WarnErrSkip:  false,
+		Handler: slog.Default().Handler(),
+		Config: &internal.Config{
+			BasePrefix:   "sql:",
+			StmtPrefix:   "stmt:",
+			TxPrefix:     "tx:",
+			WithDuration: true,
+			WarnErrSkip:  false,
+			Classifiers:  internal.DefaultClassifiers,
+		},
 	}
 
 	for _, o := range opt {
 		o.apply(&logger)
 	}
 
+	if logger.Recorder != nil {
+		logger.Recorder.OnError = func(err error) {
+			logger.Log(context.Background(), slog.LevelInfo, "record", time.Time{}, err)
+		}
+	}
+
 	return logger
 }
 
@@ -0,0 +1,89 @@
+package sqlog
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/mdigger/sqlog/sqlogtest"
+)
+
+func TestWithErrorClassifierOrder(t *testing.T) {
+	first := func(error) (ErrorClass, string, bool) { return "first", "", true }
+	second := func(error) (ErrorClass, string, bool) { return "second", "", true }
+
+	logger := newDefaultLogger(WithErrorClassifier(first), WithErrorClassifier(second))
+
+	if class, _, _ := logger.Classify(errors.New("test")); class != "first" {
+		t.Errorf("class = %q, want %q", class, "first")
+	}
+
+	if class, _, _ := logger.Classify(ErrCircuitOpen); class != "first" {
+		t.Errorf("custom classifiers must be tried before the built-in ones, got %q", class)
+	}
+}
+
+func TestWithErrorLevel(t *testing.T) {
+	discarded := errors.New("discarded")
+
+	canceled := WithErrorLevel(func(err error) (slog.Level, bool) {
+		if errors.Is(err, context.Canceled) {
+			return slog.LevelDebug, true
+		}
+
+		return 0, false
+	})
+	discard := WithErrorLevel(func(err error) (slog.Level, bool) {
+		return LevelDiscard, errors.Is(err, discarded)
+	})
+
+	tests := []struct {
+		name   string
+		opts   []Options
+		err    error
+		level  slog.Level
+		logged bool
+	}{
+		{"override", []Options{canceled, discard}, context.Canceled, slog.LevelDebug, true},
+		{"discard", []Options{canceled, discard}, discarded, 0, false},
+		{"default", []Options{canceled, discard}, errors.New("failed"), slog.LevelError, true},
+		{"errSkip", []Options{canceled, discard}, driver.ErrSkip, 0, false},
+		{"warnErrSkip", []Options{canceled, discard, WithWarnErrSkip()}, driver.ErrSkip, slog.LevelWarn, true},
+		{"first", []Options{discard, WithErrorLevel(func(error) (slog.Level, bool) {
+			return slog.LevelWarn, true
+		})}, discarded, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := sqlogtest.NewHandler()
+			logger := newDefaultLogger(append([]Options{WithHandler(h)}, tt.opts...)...)
+
+			if enabled := logger.Enabled(context.Background(), slog.LevelInfo, tt.err); enabled != tt.logged {
+				t.Errorf("Enabled() = %v, want %v", enabled, tt.logged)
+			}
+
+			logger.Log(context.Background(), slog.LevelInfo, "exec", time.Time{}, tt.err)
+
+			records := h.Records()
+			if !tt.logged {
+				if len(records) != 0 {
+					t.Errorf("dropped record is logged: %+v", records)
+				}
+
+				return
+			}
+
+			if len(records) != 1 {
+				t.Fatalf("%d records, want 1", len(records))
+			}
+
+			if records[0].Level != tt.level {
+				t.Errorf("level = %v, want %v", records[0].Level, tt.level)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package sqlog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mdigger/sqlog/sqlogtest/fakedriver"
+)
+
+// failWriter fails the writes after the first n ones.
+type failWriter struct{ n int }
+
+var errWrite = errors.New("disk full")
+
+func (w *failWriter) Write(p []byte) (int, error) {
+	if w.n <= 0 {
+		return 0, errWrite
+	}
+
+	w.n--
+
+	return len(p), nil
+}
+
+func TestRecorderError(t *testing.T) {
+	t.Run("header", func(t *testing.T) { testRecorderError(t, 0) })
+	t.Run("event", func(t *testing.T) { testRecorderError(t, 1) })
+}
+
+func testRecorderError(t *testing.T, writes int) {
+	db, _, h := openFake(t, &fakedriver.Options{Interfaces: fakedriver.All},
+		WithRecorder(&failWriter{n: writes}))
+
+	for i := 0; i < 3; i++ {
+		if _, err := db.Exec("DELETE FROM users"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var failures int
+
+	for _, r := range h.Records() {
+		if r.Op == "record" {
+			failures++
+
+			if !errors.Is(r.Err, errWrite) {
+				t.Errorf("record error = %v, want %v", r.Err, errWrite)
+			}
+		}
+	}
+
+	if failures != 1 {
+		t.Errorf("the write error is logged %d times, want once", failures)
+	}
+}
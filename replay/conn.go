@@ -0,0 +1,215 @@
+package replay
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+
+	"github.com/mdigger/sqlog/internal"
+)
+
+// conn is the connection to the recording.
+type conn struct {
+	d *Driver
+}
+
+var (
+	_ driver.Conn               = (*conn)(nil)
+	_ driver.ConnBeginTx        = (*conn)(nil)
+	_ driver.ConnPrepareContext = (*conn)(nil)
+	_ driver.ExecerContext      = (*conn)(nil)
+	_ driver.QueryerContext     = (*conn)(nil)
+	_ driver.Pinger             = (*conn)(nil)
+)
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *conn) PrepareContext(_ context.Context, query string) (driver.Stmt, error) {
+	if err := c.d.prepareError(query); err != nil {
+		return nil, err
+	}
+
+	return &stmt{conn: c, query: query}, nil
+}
+
+func (c *conn) Close() error { return nil }
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *conn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	if err := c.call(internal.EventBegin); err != nil {
+		return nil, err
+	}
+
+	return &tx{conn: c}, nil
+}
+
+func (c *conn) Ping(context.Context) error { return nil }
+
+func (c *conn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return c.exec(query, namedValues(args))
+}
+
+func (c *conn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return c.query(query, namedValues(args))
+}
+
+// call matches the call without query and returns its recorded error.
+func (c *conn) call(op string) error {
+	e, err := c.d.match(Call{Op: op})
+	if err != nil {
+		return err
+	}
+
+	if e.Error != "" {
+		return recordedError(e.Error)
+	}
+
+	return nil
+}
+
+func (c *conn) exec(query string, args []driver.Value) (driver.Result, error) {
+	e, err := c.d.match(Call{Op: internal.EventExec, Query: query, Args: args})
+	if err != nil {
+		return nil, err
+	}
+
+	if e.Error != "" {
+		return nil, recordedError(e.Error)
+	}
+
+	return result{e}, nil
+}
+
+func (c *conn) query(query string, args []driver.Value) (driver.Rows, error) {
+	e, err := c.d.match(Call{Op: internal.EventQuery, Query: query, Args: args})
+	if err != nil {
+		return nil, err
+	}
+
+	if e.Error != "" {
+		return nil, recordedError(e.Error)
+	}
+
+	r := &rows{}
+	if recorded, ok := c.d.rows[e.Seq]; ok { // read-only after loading
+		r.columns = recorded.Columns
+		r.rows = recorded.Rows
+
+		if recorded.Error != "" {
+			r.err = recordedError(recorded.Error)
+		}
+	}
+
+	return r, nil
+}
+
+func namedValues(args []driver.NamedValue) []driver.Value {
+	if len(args) == 0 {
+		return nil
+	}
+
+	result := make([]driver.Value, len(args))
+	for i, arg := range args {
+		result[i] = arg.Value
+	}
+
+	return result
+}
+
+// stmt is the prepared statement, matched on execution.
+type stmt struct {
+	conn  *conn
+	query string
+}
+
+var (
+	_ driver.Stmt             = (*stmt)(nil)
+	_ driver.StmtExecContext  = (*stmt)(nil)
+	_ driver.StmtQueryContext = (*stmt)(nil)
+)
+
+func (s *stmt) Close() error  { return nil }
+func (s *stmt) NumInput() int { return -1 }
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.conn.exec(s.query, args)
+}
+
+func (s *stmt) ExecContext(_ context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return s.conn.exec(s.query, namedValues(args))
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.conn.query(s.query, args)
+}
+
+func (s *stmt) QueryContext(_ context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return s.conn.query(s.query, namedValues(args))
+}
+
+// tx is the transaction, matched on commit and rollback.
+type tx struct {
+	conn *conn
+}
+
+func (t *tx) Commit() error   { return t.conn.call(internal.EventCommit) }
+func (t *tx) Rollback() error { return t.conn.call(internal.EventRollback) }
+
+var errNotRecorded = errors.New("replay: the value is not recorded")
+
+// result is the recorded result of the execution.
+type result struct {
+	e *internal.Event
+}
+
+func (r result) LastInsertId() (int64, error) {
+	if r.e.LastInsertID == nil {
+		return 0, errNotRecorded
+	}
+
+	return *r.e.LastInsertID, nil
+}
+
+func (r result) RowsAffected() (int64, error) {
+	if r.e.RowsAffected == nil {
+		return 0, errNotRecorded
+	}
+
+	return *r.e.RowsAffected, nil
+}
+
+// rows are the recorded rows of the query.
+type rows struct {
+	columns []string
+	rows    [][]internal.Value
+	err     error // recorded error after the rows
+}
+
+func (r *rows) Columns() []string { return r.columns }
+func (r *rows) Close() error      { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		if r.err != nil {
+			return r.err
+		}
+
+		return io.EOF
+	}
+
+	for i, v := range r.rows[0] {
+		if i < len(dest) {
+			dest[i] = v.Value
+		}
+	}
+
+	r.rows = r.rows[1:]
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package replay
+
+import "github.com/mdigger/sqlog/internal"
+
+// Fingerprint returns the normalized query: the comments are removed,
+// the string and numeric literals are replaced with "?", the whitespace
+// is kept only between words and the words are lowercased. The quoted
+// identifiers are kept as is.
+func Fingerprint(query string) string {
+	return internal.Fingerprint(query)
+}
@@ -0,0 +1,378 @@
+// Package replay provides the database/sql driver serving the traffic
+// recorded with sqlog.WithRecorder, so integration tests run without
+// a database:
+//
+//	d, err := replay.Load("testdata/session.jsonl", nil)
+//	...
+//	db := sql.OpenDB(d)
+//
+// The queries and transactions are matched with the recording in its order,
+// or by the query fingerprint, and get the recorded rows, results and errors.
+// The call that does not match the recording fails with the MismatchError.
+package replay
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/mdigger/sqlog/internal"
+)
+
+// Options are options for the Driver.
+type Options struct {
+	// ByFingerprint matches the calls with the first unused recorded call
+	// of the same query fingerprint, instead of the recorded order.
+	// It is useful for the concurrent calls.
+	ByFingerprint bool
+}
+
+// Driver is the driver.Driver and driver.Connector serving the recording.
+// All of its connections share the recording.
+type Driver struct {
+	opts   Options
+	mu     sync.Mutex
+	events []internal.Event          // recorded calls
+	rows   map[int64]*internal.Event // rows of the queries by their sequence
+	used   []bool                    // matched calls
+	next   int                       // next call in the recorded order
+}
+
+var (
+	_ driver.Driver    = (*Driver)(nil)
+	_ driver.Connector = (*Driver)(nil)
+)
+
+// New returns a new Driver serving the recording read from r.
+func New(r io.Reader, opts *Options) (*Driver, error) {
+	d := &Driver{rows: make(map[int64]*internal.Event)}
+	if opts != nil {
+		d.opts = *opts
+	}
+
+	dec := json.NewDecoder(r)
+
+	var header internal.RecordHeader
+	if err := dec.Decode(&header); err != nil {
+		return nil, fmt.Errorf("replay: read header: %w", err)
+	}
+
+	if header.Format != internal.RecordFormat || header.Version < 1 ||
+		header.Version > internal.RecordVersion {
+		return nil, fmt.Errorf("replay: unsupported recording format %q version %d",
+			header.Format, header.Version)
+	}
+
+	for {
+		var e internal.Event
+
+		err := dec.Decode(&e)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("replay: read event: %w", err)
+		}
+
+		switch {
+		case e.Op == internal.EventRows:
+			d.rows[e.Ref] = &e
+		case e.Op == internal.EventExec, e.Op == internal.EventQuery,
+			e.Op == internal.EventBegin, e.Op == internal.EventCommit,
+			e.Op == internal.EventRollback,
+			e.Op == internal.EventPrepare && e.Error != "":
+			d.events = append(d.events, e)
+		}
+	}
+
+	d.used = make([]bool, len(d.events))
+
+	return d, nil
+}
+
+// Load returns a new Driver serving the recording from the named file.
+func Load(name string, opts *Options) (*Driver, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return New(f, opts)
+}
+
+// Open returns a new connection to the recording. The name is ignored.
+func (d *Driver) Open(string) (driver.Conn, error) {
+	return &conn{d: d}, nil
+}
+
+// Connect returns a new connection to the recording.
+func (d *Driver) Connect(context.Context) (driver.Conn, error) {
+	return &conn{d: d}, nil
+}
+
+// Driver returns the Driver itself.
+func (d *Driver) Driver() driver.Driver { return d }
+
+// Finish returns an error, if some of the recorded calls were not made.
+func (d *Driver) Finish() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var unused []string
+
+	for i, e := range d.events {
+		if !d.used[i] {
+			unused = append(unused, fmt.Sprintf("  %d: %s", e.Seq, eventCall(e)))
+		}
+	}
+
+	if len(unused) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("replay: %d recorded calls were not made:\n%s",
+		len(unused), strings.Join(unused, "\n"))
+}
+
+// Call is the call of the driver.
+type Call struct {
+	Op    string
+	Query string
+	Args  []driver.Value
+}
+
+// String returns the call in one line.
+func (c Call) String() string {
+	s := c.Op
+	if c.Query != "" {
+		s += " " + c.Query
+	}
+
+	if len(c.Args) > 0 {
+		s += " " + formatArgs(c.Args)
+	}
+
+	return s
+}
+
+// lines returns the fields of the call compared in the diff.
+func (c Call) lines() []string {
+	return []string{
+		"op:    " + c.Op,
+		"query: " + c.Query,
+		"args:  " + formatArgs(c.Args),
+	}
+}
+
+// MismatchError is the error of the call that does not match the recording.
+type MismatchError struct {
+	Seq      int64 // sequence number of the expected recorded call, 0 if none
+	Expected Call  // expected recorded call, if any
+	Actual   Call  // actual call
+}
+
+// Error returns the diff of the expected and actual calls.
+func (e *MismatchError) Error() string {
+	var b strings.Builder
+
+	if e.Seq == 0 {
+		fmt.Fprintf(&b, "replay: unexpected call %s: no more recorded calls", e.Actual)
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "replay: call does not match the recorded call %d:", e.Seq)
+
+	expected, actual := e.Expected.lines(), e.Actual.lines()
+	for i := range expected {
+		if expected[i] == actual[i] {
+			b.WriteString("\n  " + expected[i])
+			continue
+		}
+
+		b.WriteString("\n- " + expected[i])
+		b.WriteString("\n+ " + actual[i])
+	}
+
+	return b.String()
+}
+
+// match returns the recorded call matching the actual call and marks it
+// as used.
+func (d *Driver) match(actual Call) (*internal.Event, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.opts.ByFingerprint {
+		return d.matchFingerprint(actual)
+	}
+
+	for d.next < len(d.events) && d.used[d.next] {
+		d.next++
+	}
+
+	if d.next == len(d.events) {
+		return nil, &MismatchError{Actual: actual}
+	}
+
+	e := &d.events[d.next]
+	if !matchOp(e, actual.Op) || e.Query != actual.Query || !equalArgs(e.Args, actual.Args) {
+		return nil, &MismatchError{Seq: e.Seq, Expected: eventCall(*e), Actual: actual}
+	}
+
+	d.used[d.next] = true
+	d.next++
+
+	return e, nil
+}
+
+// matchFingerprint returns the first unused recorded call with the same
+// fingerprint and arguments.
+func (d *Driver) matchFingerprint(actual Call) (*internal.Event, error) {
+	fingerprint := Fingerprint(actual.Query)
+	candidate := -1
+
+	for i := range d.events {
+		e := &d.events[i]
+		if d.used[i] || !matchOp(e, actual.Op) || Fingerprint(e.Query) != fingerprint {
+			continue
+		}
+
+		if equalArgs(e.Args, actual.Args) {
+			d.used[i] = true
+			return e, nil
+		}
+
+		if candidate < 0 {
+			candidate = i
+		}
+	}
+
+	if candidate < 0 {
+		return nil, &MismatchError{Actual: actual}
+	}
+
+	e := d.events[candidate]
+
+	return nil, &MismatchError{Seq: e.Seq, Expected: eventCall(e), Actual: actual}
+}
+
+// prepareError returns the recorded error of the prepared query.
+// The successful preparations are not matched.
+func (d *Driver) prepareError(query string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for i := range d.events {
+		e := &d.events[i]
+		if d.used[i] || (!d.opts.ByFingerprint && i < d.next) {
+			continue
+		}
+
+		if e.Op == internal.EventPrepare && e.Query == query {
+			d.used[i] = true
+			return recordedError(e.Error)
+		}
+
+		if !d.opts.ByFingerprint {
+			break // only the next call in the recorded order
+		}
+	}
+
+	return nil
+}
+
+// matchOp reports whether the recorded call is the operation.
+// The failed preparation matches the execution of the query.
+func matchOp(e *internal.Event, op string) bool {
+	if e.Op == internal.EventPrepare {
+		return op == internal.EventExec || op == internal.EventQuery
+	}
+
+	return e.Op == op
+}
+
+// eventCall returns the call of the recorded event.
+func eventCall(e internal.Event) Call {
+	return Call{Op: e.Op, Query: e.Query, Args: values(e.Args)}
+}
+
+// recordedError returns the error with the recorded message.
+// The well-known errors are restored to keep the database/sql behavior.
+func recordedError(msg string) error {
+	for _, err := range []error{
+		driver.ErrBadConn, context.Canceled, context.DeadlineExceeded,
+	} {
+		if msg == err.Error() {
+			return err
+		}
+	}
+
+	return errors.New(msg)
+}
+
+func values(vs []internal.Value) []driver.Value {
+	if len(vs) == 0 {
+		return nil
+	}
+
+	result := make([]driver.Value, len(vs))
+	for i, v := range vs {
+		result[i] = v.Value
+	}
+
+	return result
+}
+
+// equalArgs compares the arguments by their recorded representation.
+func equalArgs(recorded []internal.Value, args []driver.Value) bool {
+	if len(recorded) != len(args) {
+		return false
+	}
+
+	if len(args) == 0 {
+		return true
+	}
+
+	a, err := json.Marshal(recorded)
+	if err != nil {
+		return false
+	}
+
+	b, err := json.Marshal(recordArgs(args))
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(a, b)
+}
+
+func recordArgs(args []driver.Value) []internal.Value {
+	result := make([]internal.Value, len(args))
+	for i, arg := range args {
+		result[i] = internal.Value{Value: arg}
+	}
+
+	return result
+}
+
+func formatArgs(args []driver.Value) string {
+	if len(args) == 0 {
+		return "[]"
+	}
+
+	b, err := json.Marshal(recordArgs(args))
+	if err != nil {
+		return fmt.Sprint(args)
+	}
+
+	return string(b)
+}
@@ -0,0 +1,214 @@
+package replay_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mdigger/sqlog"
+	"github.com/mdigger/sqlog/replay"
+	"github.com/mdigger/sqlog/sqlogtest"
+	"github.com/mdigger/sqlog/sqlogtest/fakedriver"
+)
+
+// session runs the calls of the test session and returns their results.
+func session(t *testing.T, db *sql.DB) []any {
+	t.Helper()
+
+	ctx := context.Background()
+
+	res, err := db.ExecContext(ctx, "UPDATE users SET name = ? WHERE id = ?", "alice", int64(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT id, name FROM users WHERE id > ?", int64(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := []any{affected}
+
+	for rows.Next() {
+		var (
+			id   int64
+			name string
+		)
+
+		if err := rows.Scan(&id, &name); err != nil {
+			t.Fatal(err)
+		}
+
+		results = append(results, id, name)
+	}
+
+	if err := rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM users WHERE id = ?", int64(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	return results
+}
+
+func TestRoundTrip(t *testing.T) {
+	sql.Register("fakereplay", fakedriver.New(&fakedriver.Options{
+		Interfaces: fakedriver.All,
+		Rows: func(string, []driver.NamedValue) ([]string, [][]driver.Value) {
+			return []string{"id", "name"}, [][]driver.Value{{int64(1), "alice"}, {int64(2), "bob"}}
+		},
+		Result: func(string, []driver.NamedValue) driver.Result {
+			return driver.RowsAffected(1)
+		},
+	}))
+
+	var recording bytes.Buffer
+
+	db, err := sqlog.Open("fakereplay", "",
+		sqlog.WithHandler(sqlogtest.NewHandler()), sqlog.WithRecorder(&recording))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := session(t, db)
+	db.Close()
+
+	d, err := replay.New(&recording, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	replayed := sql.OpenDB(d)
+	defer replayed.Close()
+
+	if got := session(t, replayed); !reflect.DeepEqual(got, want) {
+		t.Errorf("replayed results = %v, want %v", got, want)
+	}
+
+	if err := d.Finish(); err != nil {
+		t.Error(err)
+	}
+}
+
+// load returns the replay driver of the recorded events.
+func load(t *testing.T, opts *replay.Options, events ...string) (*replay.Driver, *sql.DB) {
+	t.Helper()
+
+	recording := `{"format":"sqlog","version":1}` + "\n" + strings.Join(events, "\n")
+
+	d, err := replay.New(strings.NewReader(recording), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db := sql.OpenDB(d)
+	t.Cleanup(func() { db.Close() })
+
+	return d, db
+}
+
+func TestMismatchError(t *testing.T) {
+	_, db := load(t, nil,
+		`{"seq":1,"op":"exec","query":"DELETE FROM users WHERE id = ?","args":[{"int64":1}]}`)
+
+	_, err := db.Exec("DELETE FROM users WHERE id = ?", 2)
+
+	var mismatch *replay.MismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("error = %v, want MismatchError", err)
+	}
+
+	want := `replay: call does not match the recorded call 1:
+  op:    exec
+  query: DELETE FROM users WHERE id = ?
+- args:  [{"int64":1}]
++ args:  [{"int64":2}]`
+	if err.Error() != want {
+		t.Errorf("error:\n%s\nwant:\n%s", err, want)
+	}
+
+	if _, err := db.Exec("DELETE FROM users WHERE id = ?", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.Exec("SELECT 1")
+
+	want = "replay: unexpected call exec SELECT 1: no more recorded calls"
+	if err == nil || err.Error() != want {
+		t.Errorf("error = %v, want %s", err, want)
+	}
+}
+
+func TestByFingerprint(t *testing.T) {
+	events := []string{
+		`{"seq":1,"op":"query","query":"SELECT name FROM users WHERE id = ?","args":[{"int64":1}]}`,
+		`{"seq":2,"op":"exec","query":"DELETE FROM users WHERE id = 2"}`,
+	}
+
+	_, db := load(t, nil, events...)
+	if _, err := db.Exec("DELETE FROM users WHERE id = 2"); err == nil {
+		t.Error("the call out of the recorded order is matched")
+	}
+
+	d, db := load(t, &replay.Options{ByFingerprint: true}, events...)
+
+	// the literals, whitespace and case of the queries differ
+	if _, err := db.Exec("delete from users\n where id = 3"); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Query("select name from users where id = ?", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows.Close()
+
+	if err := d.Finish(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPrepareError(t *testing.T) {
+	for _, opts := range []*replay.Options{nil, {ByFingerprint: true}} {
+		_, db := load(t, opts,
+			`{"seq":1,"op":"exec","query":"DELETE FROM users"}`,
+			`{"seq":2,"op":"prepare","query":"SELEC 1","error":"syntax error"}`,
+			`{"seq":3,"op":"prepare","query":"SELEC 2","error":"syntax error"}`)
+
+		if _, err := db.Exec("DELETE FROM users"); err != nil {
+			t.Fatal(err)
+		}
+
+		// the failed preparation is matched by the preparation
+		if _, err := db.Prepare("SELEC 1"); err == nil || err.Error() != "syntax error" {
+			t.Errorf("prepare error = %v, want the recorded one", err)
+		}
+
+		// and by the execution of the query without preparation
+		if _, err := db.Exec("SELEC 2"); err == nil || err.Error() != "syntax error" {
+			t.Errorf("exec error = %v, want the recorded one", err)
+		}
+	}
+}
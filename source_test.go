@@ -0,0 +1,85 @@
+package sqlog_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"log/slog"
+	"runtime"
+	"testing"
+
+	"github.com/mdigger/sqlog"
+	"github.com/mdigger/sqlog/sqlogtest/fakedriver"
+)
+
+func init() {
+	sql.Register("fakesource", fakedriver.New(&fakedriver.Options{Interfaces: fakedriver.All}))
+}
+
+// The test is in the external package, as the frames of the sqlog
+// package are skipped.
+func TestSource(t *testing.T) {
+	var buf bytes.Buffer
+
+	db, err := sqlog.Open("fakesource", "", sqlog.WithHandler(slog.NewJSONHandler(&buf,
+		&slog.HandlerOptions{AddSource: true, Level: slog.LevelDebug})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	_, file, line, _ := runtime.Caller(0)
+	tx, err := db.Begin() // line + 1
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tx.Rollback(); err != nil { // line + 6
+		t.Fatal(err)
+	}
+
+	rows, err := db.Query("SELECT 1") // line + 10
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rows.Close(); err != nil { // line + 15
+		t.Fatal(err)
+	}
+
+	want := map[string]int{
+		"fakesource:beginTx":      line + 1,
+		"fakesource:tx:rollback":  line + 6,
+		"fakesource:queryContext": line + 10,
+	}
+	lines := make(map[string][]int)
+
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var r struct {
+			Msg    string
+			Source slog.Source
+		}
+
+		if err := dec.Decode(&r); err != nil {
+			t.Fatal(err)
+		}
+
+		if r.Source.File != file {
+			t.Errorf("%s: source = %s:%d, want %s", r.Msg, r.Source.File, r.Source.Line, file)
+		}
+
+		lines[r.Msg] = append(lines[r.Msg], r.Source.Line)
+	}
+
+	for msg, line := range want {
+		if got := lines[msg]; len(got) != 1 || got[0] != line {
+			t.Errorf("%s: source lines = %v, want %d", msg, got, line)
+		}
+	}
+
+	// rows.Close returns the connection to the pool, checking its validity
+	if got := lines["fakesource:isValid"]; len(got) == 0 || got[len(got)-1] != line+15 {
+		t.Errorf("isValid: source lines = %v, want the last one %d", got, line+15)
+	}
+}
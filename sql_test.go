@@ -0,0 +1,50 @@
+package sqlog
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mdigger/sqlog/sqlogtest"
+	"github.com/mdigger/sqlog/sqlogtest/fakedriver"
+)
+
+var fakeDrivers atomic.Int64
+
+// openFake opens the database of the new fake driver, logged to the new
+// sqlogtest handler.
+func openFake(t testing.TB, opts *fakedriver.Options, opt ...Options) (*sql.DB, *fakedriver.Driver, *sqlogtest.Handler) {
+	t.Helper()
+
+	d := fakedriver.New(opts)
+	name := "fake" + strconv.FormatInt(fakeDrivers.Add(1), 10)
+	sql.Register(name, d)
+
+	h := sqlogtest.NewHandler()
+
+	db, err := Open(name, "", append([]Options{WithHandler(h)}, opt...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { db.Close() })
+
+	return db, d, h
+}
+
+func TestOpen(t *testing.T) {
+	db, _, h := openFake(t, &fakedriver.Options{Interfaces: fakedriver.All})
+
+	if _, err := db.Exec("INSERT INTO users (name) VALUES (?)", "alice"); err != nil {
+		t.Fatal(err)
+	}
+
+	r := h.ExpectExec(t, `^INSERT INTO users`)
+	if !strings.HasPrefix(r.Message, "fake") || r.ConnID == "" {
+		t.Errorf("unexpected record %+v", r)
+	}
+
+	h.AssertNoQueries(t)
+}
@@ -0,0 +1,163 @@
+package sqlogtest
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// ExpectQuery checks that the next executed statement is the query
+// matching the regular expression pattern and returns its record.
+// Otherwise the test fails immediately.
+func (h *Handler) ExpectQuery(t testing.TB, pattern string) Record {
+	t.Helper()
+
+	return h.expect(t, "query", pattern)
+}
+
+// ExpectExec checks that the next executed statement is the execution
+// of the query matching the regular expression pattern and returns
+// its record. Otherwise the test fails immediately.
+func (h *Handler) ExpectExec(t testing.TB, pattern string) Record {
+	t.Helper()
+
+	return h.expect(t, "exec", pattern)
+}
+
+// ExpectBegin checks that the next executed statement is the beginning
+// of the transaction and returns its record.
+func (h *Handler) ExpectBegin(t testing.TB) Record {
+	t.Helper()
+
+	return h.expect(t, "begin", "")
+}
+
+// ExpectCommit checks that the next executed statement is the commit
+// of the transaction and returns its record.
+func (h *Handler) ExpectCommit(t testing.TB) Record {
+	t.Helper()
+
+	return h.expect(t, "commit", "")
+}
+
+// ExpectRollback checks that the next executed statement is the rollback
+// of the transaction and returns its record.
+func (h *Handler) ExpectRollback(t testing.TB) Record {
+	t.Helper()
+
+	return h.expect(t, "rollback", "")
+}
+
+// AssertNoQueries checks that there are no executed statements left after
+// the expected ones, so the code path issued exactly the expected statements.
+// It reports the unexpected statements without stopping the test.
+func (h *Handler) AssertNoQueries(t testing.TB) bool {
+	t.Helper()
+
+	h.state.mu.Lock()
+	rest := h.rest()
+	h.state.mu.Unlock()
+
+	if len(rest) == 0 {
+		return true
+	}
+
+	t.Errorf("sqlogtest: %d unexpected statements:\n%s", len(rest), formatRecords(rest))
+
+	return false
+}
+
+// QueryCount returns the number of executed queries and executions.
+// The retried statement is counted once.
+func (h *Handler) QueryCount() int {
+	var count int
+
+	for _, r := range h.Statements() {
+		if r.Op == "query" || r.Op == "exec" {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (h *Handler) expect(t testing.TB, op, pattern string) Record {
+	t.Helper()
+
+	var re *regexp.Regexp
+
+	if pattern != "" {
+		var err error
+		if re, err = regexp.Compile(pattern); err != nil {
+			t.Fatalf("sqlogtest: invalid pattern: %v", err)
+		}
+	}
+
+	h.state.mu.Lock()
+	defer h.state.mu.Unlock()
+
+	expected := op
+	if pattern != "" {
+		expected += " " + pattern
+	}
+
+	rest := h.rest()
+	if len(rest) == 0 {
+		t.Fatalf("sqlogtest: expected %s, but there are no more statements", expected)
+	}
+
+	r := rest[0]
+	if r.Op != op || (re != nil && !re.MatchString(r.Query)) {
+		t.Fatalf("sqlogtest: expected %s, but got:\n%s", expected, formatRecords(rest))
+	}
+
+	h.state.next = r.index + 1
+
+	return r.Record
+}
+
+// indexedRecord is the record with its index in the collected records.
+type indexedRecord struct {
+	Record
+	index int
+}
+
+// rest returns the executed statements after the expected ones.
+func (h *Handler) rest() []indexedRecord {
+	var rest []indexedRecord
+
+	for i := h.state.next; i < len(h.state.records); i++ {
+		if r := h.state.records[i]; r.isStatement() {
+			rest = append(rest, indexedRecord{r, i})
+		}
+	}
+
+	return rest
+}
+
+func formatRecords(records []indexedRecord) string {
+	var b strings.Builder
+
+	for i, r := range records {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+
+		fmt.Fprintf(&b, "\t%s", r.Op)
+
+		if r.Query != "" {
+			fmt.Fprintf(&b, " %s", r.Query)
+		}
+
+		if len(r.Args) > 0 {
+			fmt.Fprintf(&b, " %v", r.Args)
+		}
+
+		if r.Err != nil {
+			fmt.Fprintf(&b, " error: %v", r.Err)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,189 @@
+package sqlogtest_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mdigger/sqlog"
+	"github.com/mdigger/sqlog/sqlogtest"
+	"github.com/mdigger/sqlog/sqlogtest/fakedriver"
+)
+
+var drivers atomic.Int64
+
+// open returns the database of the new fake driver logged to the new handler.
+func open(t *testing.T, opts *fakedriver.Options, opt ...sqlog.Options) (*sql.DB, *sqlogtest.Handler) {
+	t.Helper()
+
+	name := fmt.Sprintf("fakeexpect%d", drivers.Add(1))
+	sql.Register(name, fakedriver.New(opts))
+
+	h := sqlogtest.NewHandler()
+
+	db, err := sqlog.Open(name, "", append([]sqlog.Options{sqlog.WithHandler(h)}, opt...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { db.Close() })
+
+	return db, h
+}
+
+// fakeT records the failures of the expectations. Its Fatalf stops
+// the expectation by the panic recovered by failure.
+type fakeT struct {
+	testing.TB
+	errors []string
+}
+
+type fatal struct{}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) Fatalf(format string, args ...any) {
+	t.Errorf(format, args...)
+	panic(fatal{})
+}
+
+// failure returns the failure of the expectations, if any.
+func failure(expect func(t testing.TB)) (msg string) {
+	ft := new(fakeT)
+
+	defer func() {
+		if r := recover(); r != nil && r != (fatal{}) {
+			panic(r)
+		}
+
+		msg = strings.Join(ft.errors, "\n")
+	}()
+
+	expect(ft)
+
+	return ""
+}
+
+func TestExpect(t *testing.T) {
+	db, h := open(t, &fakedriver.Options{Interfaces: fakedriver.All})
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tx.Exec("INSERT INTO users (name) VALUES (?)", "alice"); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := tx.Query("SELECT id FROM users WHERE name = ?", "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows.Close()
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err = db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := h.QueryCount(); n != 2 {
+		t.Errorf("QueryCount() = %d, want 2", n)
+	}
+
+	h.ExpectBegin(t)
+
+	if r := h.ExpectExec(t, `^INSERT INTO users`); len(r.Args) != 1 || r.Args[0] != "alice" {
+		t.Errorf("unexpected exec record %+v", r)
+	}
+
+	h.ExpectQuery(t, `FROM users WHERE name`)
+	h.ExpectCommit(t)
+	h.ExpectBegin(t)
+	h.ExpectRollback(t)
+	h.AssertNoQueries(t)
+}
+
+func TestExpectFailures(t *testing.T) {
+	db, h := open(t, &fakedriver.Options{Interfaces: fakedriver.All})
+
+	if _, err := db.Exec("DELETE FROM users"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		expect func(t testing.TB)
+		want   string
+	}{
+		{"op", func(t testing.TB) { h.ExpectQuery(t, "") }, "expected query, but got:\n\texec DELETE FROM users"},
+		{"pattern", func(t testing.TB) { h.ExpectExec(t, "^UPDATE") }, "expected exec ^UPDATE, but got:"},
+		{"invalid", func(t testing.TB) { h.ExpectExec(t, "(") }, "invalid pattern"},
+		{"rest", func(t testing.TB) { h.AssertNoQueries(t) }, "1 unexpected statements:\n\texec DELETE FROM users"},
+		{"end", func(t testing.TB) {
+			h.ExpectExec(t, "^DELETE")
+			h.ExpectCommit(t)
+		}, "expected commit, but there are no more statements"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if msg := failure(tt.expect); !strings.Contains(msg, tt.want) {
+				t.Errorf("failure = %q, want %q", msg, tt.want)
+			}
+		})
+	}
+}
+
+type deadlock struct{}
+
+func (deadlock) Error() string    { return "deadlock detected" }
+func (deadlock) SQLState() string { return "40P01" }
+
+func TestExpectRetried(t *testing.T) {
+	var calls atomic.Int64
+
+	db, h := open(t, &fakedriver.Options{
+		Interfaces: fakedriver.All,
+		Err: func(call, _ string) error {
+			if call == "Conn.QueryContext" && calls.Add(1) == 1 {
+				return deadlock{}
+			}
+
+			return nil
+		},
+	}, sqlog.WithRetry(sqlog.RetryPolicy{Ops: sqlog.OpQuery, MaxAttempts: 2}))
+
+	rows, err := db.QueryContext(sqlog.ContextWithRetry(context.Background()), "SELECT 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows.Close()
+
+	if n := h.QueryCount(); n != 1 {
+		t.Errorf("QueryCount() = %d, want 1", n)
+	}
+
+	if r := h.ExpectQuery(t, "SELECT 1"); r.Attrs["attempt"].Int64() != 2 {
+		t.Errorf("attempt = %v, want 2", r.Attrs["attempt"])
+	}
+
+	h.AssertNoQueries(t)
+}
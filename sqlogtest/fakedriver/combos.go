@@ -0,0 +1,4465 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package fakedriver
+
+import "database/sql/driver"
+
+// wrapConn returns c implementing exactly the set of the optional interfaces.
+func wrapConn(c *conn, set Interface) driver.Conn {
+	switch set & connInterfaces {
+	case 0:
+		return c
+	case Pinger:
+		return struct {
+			*conn
+			*pinger
+		}{c, (*pinger)(c)}
+	case Legacy:
+		return struct {
+			*conn
+			*legacy
+		}{c, (*legacy)(c)}
+	case Pinger | Legacy:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+		}{c, (*pinger)(c), (*legacy)(c)}
+	case ExecerContext:
+		return struct {
+			*conn
+			*execerContext
+		}{c, (*execerContext)(c)}
+	case Pinger | ExecerContext:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+		}{c, (*pinger)(c), (*execerContext)(c)}
+	case Legacy | ExecerContext:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+		}{c, (*legacy)(c), (*execerContext)(c)}
+	case Pinger | Legacy | ExecerContext:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c)}
+	case QueryerContext:
+		return struct {
+			*conn
+			*queryerContext
+		}{c, (*queryerContext)(c)}
+	case Pinger | QueryerContext:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+		}{c, (*pinger)(c), (*queryerContext)(c)}
+	case Legacy | QueryerContext:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+		}{c, (*legacy)(c), (*queryerContext)(c)}
+	case Pinger | Legacy | QueryerContext:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c)}
+	case ExecerContext | QueryerContext:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+		}{c, (*execerContext)(c), (*queryerContext)(c)}
+	case Pinger | ExecerContext | QueryerContext:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c)}
+	case Legacy | ExecerContext | QueryerContext:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c)}
+	case ConnPrepareContext:
+		return struct {
+			*conn
+			*connPrepareContext
+		}{c, (*connPrepareContext)(c)}
+	case Pinger | ConnPrepareContext:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+		}{c, (*pinger)(c), (*connPrepareContext)(c)}
+	case Legacy | ConnPrepareContext:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+		}{c, (*legacy)(c), (*connPrepareContext)(c)}
+	case Pinger | Legacy | ConnPrepareContext:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c)}
+	case ExecerContext | ConnPrepareContext:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+		}{c, (*execerContext)(c), (*connPrepareContext)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c)}
+	case QueryerContext | ConnPrepareContext:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c)}
+	case ConnBeginTx:
+		return struct {
+			*conn
+			*connBeginTx
+		}{c, (*connBeginTx)(c)}
+	case Pinger | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*connBeginTx
+		}{c, (*pinger)(c), (*connBeginTx)(c)}
+	case Legacy | ConnBeginTx:
+		return struct {
+			*conn
+			*legacy
+			*connBeginTx
+		}{c, (*legacy)(c), (*connBeginTx)(c)}
+	case Pinger | Legacy | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connBeginTx
+		}{c, (*pinger)(c), (*legacy)(c), (*connBeginTx)(c)}
+	case ExecerContext | ConnBeginTx:
+		return struct {
+			*conn
+			*execerContext
+			*connBeginTx
+		}{c, (*execerContext)(c), (*connBeginTx)(c)}
+	case Pinger | ExecerContext | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connBeginTx
+		}{c, (*pinger)(c), (*execerContext)(c), (*connBeginTx)(c)}
+	case Legacy | ExecerContext | ConnBeginTx:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connBeginTx
+		}{c, (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c)}
+	case Pinger | Legacy | ExecerContext | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connBeginTx
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c)}
+	case QueryerContext | ConnBeginTx:
+		return struct {
+			*conn
+			*queryerContext
+			*connBeginTx
+		}{c, (*queryerContext)(c), (*connBeginTx)(c)}
+	case Pinger | QueryerContext | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connBeginTx
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connBeginTx)(c)}
+	case Legacy | QueryerContext | ConnBeginTx:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connBeginTx
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c)}
+	case Pinger | Legacy | QueryerContext | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connBeginTx
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c)}
+	case ExecerContext | QueryerContext | ConnBeginTx:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connBeginTx
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connBeginTx
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnBeginTx:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c)}
+	case ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case Pinger | ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*pinger)(c), (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case Legacy | ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case Pinger | Legacy | ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case ExecerContext | ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case QueryerContext | ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c)}
+	case SessionResetter:
+		return struct {
+			*conn
+			*sessionResetter
+		}{c, (*sessionResetter)(c)}
+	case Pinger | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*sessionResetter
+		}{c, (*pinger)(c), (*sessionResetter)(c)}
+	case Legacy | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*sessionResetter
+		}{c, (*legacy)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*sessionResetter)(c)}
+	case ExecerContext | SessionResetter:
+		return struct {
+			*conn
+			*execerContext
+			*sessionResetter
+		}{c, (*execerContext)(c), (*sessionResetter)(c)}
+	case Pinger | ExecerContext | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*sessionResetter
+		}{c, (*pinger)(c), (*execerContext)(c), (*sessionResetter)(c)}
+	case Legacy | ExecerContext | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*sessionResetter
+		}{c, (*legacy)(c), (*execerContext)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | ExecerContext | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*sessionResetter)(c)}
+	case QueryerContext | SessionResetter:
+		return struct {
+			*conn
+			*queryerContext
+			*sessionResetter
+		}{c, (*queryerContext)(c), (*sessionResetter)(c)}
+	case Pinger | QueryerContext | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*sessionResetter
+		}{c, (*pinger)(c), (*queryerContext)(c), (*sessionResetter)(c)}
+	case Legacy | QueryerContext | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*sessionResetter
+		}{c, (*legacy)(c), (*queryerContext)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | QueryerContext | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*sessionResetter)(c)}
+	case ExecerContext | QueryerContext | SessionResetter:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*sessionResetter
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c)}
+	case Pinger | ExecerContext | QueryerContext | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*sessionResetter
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c)}
+	case Legacy | ExecerContext | QueryerContext | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*sessionResetter
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c)}
+	case ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case Pinger | ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*pinger)(c), (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case Legacy | ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*legacy)(c), (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case ExecerContext | ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case QueryerContext | ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c)}
+	case ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*connBeginTx
+			*sessionResetter
+		}{c, (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Legacy | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*connBeginTx
+			*sessionResetter
+		}{c, (*legacy)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case ExecerContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | ExecerContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Legacy | ExecerContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | ExecerContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case QueryerContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | QueryerContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Legacy | QueryerContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | QueryerContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case ExecerContext | QueryerContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Legacy | ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c)}
+	case NamedValueChecker:
+		return struct {
+			*conn
+			*namedValueChecker
+		}{c, (*namedValueChecker)(c)}
+	case Pinger | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*namedValueChecker
+		}{c, (*pinger)(c), (*namedValueChecker)(c)}
+	case Legacy | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*namedValueChecker
+		}{c, (*legacy)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*namedValueChecker)(c)}
+	case ExecerContext | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*namedValueChecker)(c)}
+	case QueryerContext | NamedValueChecker:
+		return struct {
+			*conn
+			*queryerContext
+			*namedValueChecker
+		}{c, (*queryerContext)(c), (*namedValueChecker)(c)}
+	case Pinger | QueryerContext | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*namedValueChecker
+		}{c, (*pinger)(c), (*queryerContext)(c), (*namedValueChecker)(c)}
+	case Legacy | QueryerContext | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*namedValueChecker
+		}{c, (*legacy)(c), (*queryerContext)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | QueryerContext | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*namedValueChecker)(c)}
+	case ExecerContext | QueryerContext | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | QueryerContext | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | QueryerContext | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*namedValueChecker)(c)}
+	case ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case Pinger | ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*pinger)(c), (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case Legacy | ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*legacy)(c), (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case ExecerContext | ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case QueryerContext | ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c)}
+	case ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Legacy | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*legacy)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case ExecerContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case QueryerContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | QueryerContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Legacy | QueryerContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | QueryerContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case ExecerContext | QueryerContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Legacy | ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case ExecerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c)}
+	case SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case ExecerContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case QueryerContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | QueryerContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | QueryerContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | QueryerContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case ExecerContext | QueryerContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | QueryerContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | QueryerContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case ExecerContext | ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case ExecerContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case ExecerContext | QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c)}
+	case Validator:
+		return struct {
+			*conn
+			*validator
+		}{c, (*validator)(c)}
+	case Pinger | Validator:
+		return struct {
+			*conn
+			*pinger
+			*validator
+		}{c, (*pinger)(c), (*validator)(c)}
+	case Legacy | Validator:
+		return struct {
+			*conn
+			*legacy
+			*validator
+		}{c, (*legacy)(c), (*validator)(c)}
+	case Pinger | Legacy | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*validator)(c)}
+	case ExecerContext | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*validator
+		}{c, (*execerContext)(c), (*validator)(c)}
+	case Pinger | ExecerContext | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*validator)(c)}
+	case Legacy | ExecerContext | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*validator)(c)}
+	case QueryerContext | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*validator
+		}{c, (*queryerContext)(c), (*validator)(c)}
+	case Pinger | QueryerContext | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*validator)(c)}
+	case Legacy | QueryerContext | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*validator)(c)}
+	case ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*connPrepareContext
+			*validator
+		}{c, (*connPrepareContext)(c), (*validator)(c)}
+	case Pinger | ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+			*validator
+		}{c, (*pinger)(c), (*connPrepareContext)(c), (*validator)(c)}
+	case Legacy | ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+			*validator
+		}{c, (*legacy)(c), (*connPrepareContext)(c), (*validator)(c)}
+	case Pinger | Legacy | ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c), (*validator)(c)}
+	case ExecerContext | ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+			*validator
+		}{c, (*execerContext)(c), (*connPrepareContext)(c), (*validator)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c), (*validator)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*validator)(c)}
+	case QueryerContext | ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+			*validator
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c), (*validator)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*validator)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*validator)(c)}
+	case ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*connBeginTx
+			*validator
+		}{c, (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Legacy | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*legacy
+			*connBeginTx
+			*validator
+		}{c, (*legacy)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | Legacy | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*connBeginTx)(c), (*validator)(c)}
+	case ExecerContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*connBeginTx
+			*validator
+		}{c, (*execerContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | ExecerContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Legacy | ExecerContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connBeginTx
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case QueryerContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*connBeginTx
+			*validator
+		}{c, (*queryerContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | QueryerContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Legacy | QueryerContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connBeginTx
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Legacy | ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | Legacy | ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case ExecerContext | ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case QueryerContext | ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*validator)(c)}
+	case SessionResetter | Validator:
+		return struct {
+			*conn
+			*sessionResetter
+			*validator
+		}{c, (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*sessionResetter)(c), (*validator)(c)}
+	case ExecerContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*sessionResetter
+			*validator
+		}{c, (*execerContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | ExecerContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | ExecerContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case QueryerContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*sessionResetter
+			*validator
+		}{c, (*queryerContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | QueryerContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | QueryerContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*sessionResetter
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case ExecerContext | ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case QueryerContext | ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*validator)(c)}
+	case ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case ExecerContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | ExecerContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | ExecerContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case QueryerContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | QueryerContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | QueryerContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*validator)(c)}
+	case NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*namedValueChecker
+			*validator
+		}{c, (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case QueryerContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*namedValueChecker
+			*validator
+		}{c, (*queryerContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | QueryerContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | QueryerContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case QueryerContext | ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case QueryerContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | QueryerContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | QueryerContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case QueryerContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | QueryerContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | QueryerContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	case Pinger | Legacy | ExecerContext | QueryerContext | ConnPrepareContext | ConnBeginTx | SessionResetter | NamedValueChecker | Validator:
+		return struct {
+			*conn
+			*pinger
+			*legacy
+			*execerContext
+			*queryerContext
+			*connPrepareContext
+			*connBeginTx
+			*sessionResetter
+			*namedValueChecker
+			*validator
+		}{c, (*pinger)(c), (*legacy)(c), (*execerContext)(c), (*queryerContext)(c), (*connPrepareContext)(c), (*connBeginTx)(c), (*sessionResetter)(c), (*namedValueChecker)(c), (*validator)(c)}
+	default:
+		panic("unreachable")
+	}
+}
+
+// wrapStmt returns s implementing exactly the set of the optional interfaces.
+func wrapStmt(s *stmt, set Interface) driver.Stmt {
+	switch set & stmtInterfaces {
+	case 0:
+		return s
+	case StmtExecContext:
+		return struct {
+			*stmt
+			*stmtExecContext
+		}{s, (*stmtExecContext)(s)}
+	case StmtQueryContext:
+		return struct {
+			*stmt
+			*stmtQueryContext
+		}{s, (*stmtQueryContext)(s)}
+	case StmtExecContext | StmtQueryContext:
+		return struct {
+			*stmt
+			*stmtExecContext
+			*stmtQueryContext
+		}{s, (*stmtExecContext)(s), (*stmtQueryContext)(s)}
+	case StmtNamedValueChecker:
+		return struct {
+			*stmt
+			*stmtNamedValueChecker
+		}{s, (*stmtNamedValueChecker)(s)}
+	case StmtExecContext | StmtNamedValueChecker:
+		return struct {
+			*stmt
+			*stmtExecContext
+			*stmtNamedValueChecker
+		}{s, (*stmtExecContext)(s), (*stmtNamedValueChecker)(s)}
+	case StmtQueryContext | StmtNamedValueChecker:
+		return struct {
+			*stmt
+			*stmtQueryContext
+			*stmtNamedValueChecker
+		}{s, (*stmtQueryContext)(s), (*stmtNamedValueChecker)(s)}
+	case StmtExecContext | StmtQueryContext | StmtNamedValueChecker:
+		return struct {
+			*stmt
+			*stmtExecContext
+			*stmtQueryContext
+			*stmtNamedValueChecker
+		}{s, (*stmtExecContext)(s), (*stmtQueryContext)(s), (*stmtNamedValueChecker)(s)}
+	case ColumnConverter:
+		return struct {
+			*stmt
+			*columnConverter
+		}{s, (*columnConverter)(s)}
+	case StmtExecContext | ColumnConverter:
+		return struct {
+			*stmt
+			*stmtExecContext
+			*columnConverter
+		}{s, (*stmtExecContext)(s), (*columnConverter)(s)}
+	case StmtQueryContext | ColumnConverter:
+		return struct {
+			*stmt
+			*stmtQueryContext
+			*columnConverter
+		}{s, (*stmtQueryContext)(s), (*columnConverter)(s)}
+	case StmtExecContext | StmtQueryContext | ColumnConverter:
+		return struct {
+			*stmt
+			*stmtExecContext
+			*stmtQueryContext
+			*columnConverter
+		}{s, (*stmtExecContext)(s), (*stmtQueryContext)(s), (*columnConverter)(s)}
+	case StmtNamedValueChecker | ColumnConverter:
+		return struct {
+			*stmt
+			*stmtNamedValueChecker
+			*columnConverter
+		}{s, (*stmtNamedValueChecker)(s), (*columnConverter)(s)}
+	case StmtExecContext | StmtNamedValueChecker | ColumnConverter:
+		return struct {
+			*stmt
+			*stmtExecContext
+			*stmtNamedValueChecker
+			*columnConverter
+		}{s, (*stmtExecContext)(s), (*stmtNamedValueChecker)(s), (*columnConverter)(s)}
+	case StmtQueryContext | StmtNamedValueChecker | ColumnConverter:
+		return struct {
+			*stmt
+			*stmtQueryContext
+			*stmtNamedValueChecker
+			*columnConverter
+		}{s, (*stmtQueryContext)(s), (*stmtNamedValueChecker)(s), (*columnConverter)(s)}
+	case StmtExecContext | StmtQueryContext | StmtNamedValueChecker | ColumnConverter:
+		return struct {
+			*stmt
+			*stmtExecContext
+			*stmtQueryContext
+			*stmtNamedValueChecker
+			*columnConverter
+		}{s, (*stmtExecContext)(s), (*stmtQueryContext)(s), (*stmtNamedValueChecker)(s), (*columnConverter)(s)}
+	default:
+		panic("unreachable")
+	}
+}
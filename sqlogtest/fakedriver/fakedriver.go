@@ -0,0 +1,391 @@
+// Package fakedriver provides the configurable in-memory database/sql
+// driver for testing the driver wrappers, such as sqlog, and the code using
+// them without a database. Its connections and statements implement exactly
+// the selected optional driver interfaces, serve the configured rows and
+// results, record the called methods and inject errors and delays:
+//
+//	d := fakedriver.New(&fakedriver.Options{
+//		Interfaces: fakedriver.ExecerContext | fakedriver.ConnBeginTx,
+//		Err: func(call, query string) error {
+//			if call == "Tx.Commit" {
+//				return errors.New("serialization failure")
+//			}
+//			return nil
+//		},
+//	})
+//	sql.Register("fake", d)
+package fakedriver
+
+//go:generate go run gen.go
+
+import (
+	"context"
+	"database/sql/driver"
+	"io"
+	"sync"
+	"time"
+)
+
+// Interface is the set of the optional driver interfaces.
+type Interface uint
+
+// Optional interfaces of the connections. The Legacy connection
+// implements both deprecated driver.Execer and driver.Queryer.
+const (
+	Pinger Interface = 1 << iota
+	Legacy
+	ExecerContext
+	QueryerContext
+	ConnPrepareContext
+	ConnBeginTx
+	SessionResetter
+	NamedValueChecker
+	Validator
+)
+
+// Optional interfaces of the statements.
+const (
+	StmtExecContext Interface = 1 << (iota + 9)
+	StmtQueryContext
+	StmtNamedValueChecker
+	ColumnConverter
+)
+
+// All is the set of all optional interfaces.
+const All = 1<<13 - 1
+
+const (
+	connInterfaces = 1<<9 - 1
+	stmtInterfaces = All &^ connInterfaces
+)
+
+// Options are options for the Driver.
+type Options struct {
+	// Interfaces are the optional interfaces of the connections
+	// and statements. None by default.
+	Interfaces Interface
+
+	// Delay is the delay of the calls, canceled with their context.
+	Delay time.Duration
+
+	// Err returns the injected error of the call with the query, if any.
+	// The call is the method name with its receiver, e.g. "Conn.ExecContext",
+	// "Stmt.Query" or "Tx.Commit".
+	Err func(call, query string) error
+
+	// Rows returns the columns and rows of the query. No rows by default.
+	Rows func(query string, args []driver.NamedValue) (columns []string, rows [][]driver.Value)
+
+	// Result returns the result of the execution. No rows affected by default.
+	Result func(query string, args []driver.NamedValue) driver.Result
+}
+
+// Driver is the fake driver.Driver. It is safe for concurrent use.
+type Driver struct {
+	opts  Options
+	mu    sync.Mutex
+	calls []string
+}
+
+// New returns a new fake Driver.
+func New(opts *Options) *Driver {
+	d := new(Driver)
+	if opts != nil {
+		d.opts = *opts
+	}
+
+	return d
+}
+
+var _ driver.Driver = (*Driver)(nil)
+
+// Open returns a new connection. The name is ignored.
+func (d *Driver) Open(string) (driver.Conn, error) {
+	if err := d.call(context.Background(), "Driver.Open", ""); err != nil {
+		return nil, err
+	}
+
+	return wrapConn(&conn{d: d}, d.opts.Interfaces), nil
+}
+
+// Calls returns the names of the called methods in their order,
+// e.g. "Conn.ExecContext".
+func (d *Driver) Calls() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return append([]string(nil), d.calls...)
+}
+
+// ResetCalls removes the recorded calls.
+func (d *Driver) ResetCalls() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.calls = nil
+}
+
+// record records the call and returns its injected error.
+func (d *Driver) record(call, query string) error {
+	d.mu.Lock()
+	d.calls = append(d.calls, call)
+	d.mu.Unlock()
+
+	if d.opts.Err != nil {
+		return d.opts.Err(call, query)
+	}
+
+	return nil
+}
+
+// call records the call, waits for the delay and returns its injected error.
+func (d *Driver) call(ctx context.Context, call, query string) error {
+	if err := d.record(call, query); err != nil {
+		return err
+	}
+
+	if d.opts.Delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d.opts.Delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *Driver) result(query string, args []driver.NamedValue) driver.Result {
+	if d.opts.Result != nil {
+		return d.opts.Result(query, args)
+	}
+
+	return driver.RowsAffected(0)
+}
+
+func (d *Driver) rows(query string, args []driver.NamedValue) driver.Rows {
+	r := new(rows)
+	if d.opts.Rows != nil {
+		r.columns, r.rows = d.opts.Rows(query, args)
+	}
+
+	return r
+}
+
+// conn is the connection with the driver.Conn methods. Its optional methods
+// are implemented by the types of the same structure, combined by wrapConn.
+type conn struct {
+	d *Driver
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return c.prepare(context.Background(), "Conn.Prepare", query)
+}
+
+func (c *conn) Close() error {
+	return c.d.call(context.Background(), "Conn.Close", "")
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.begin(context.Background(), "Conn.Begin")
+}
+
+func (c *conn) prepare(ctx context.Context, call, query string) (driver.Stmt, error) {
+	if err := c.d.call(ctx, call, query); err != nil {
+		return nil, err
+	}
+
+	return wrapStmt(&stmt{d: c.d, query: query}, c.d.opts.Interfaces), nil
+}
+
+func (c *conn) begin(ctx context.Context, call string) (driver.Tx, error) {
+	if err := c.d.call(ctx, call, ""); err != nil {
+		return nil, err
+	}
+
+	return &tx{d: c.d}, nil
+}
+
+func (c *conn) exec(ctx context.Context, call, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := c.d.call(ctx, call, query); err != nil {
+		return nil, err
+	}
+
+	return c.d.result(query, args), nil
+}
+
+func (c *conn) query(ctx context.Context, call, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := c.d.call(ctx, call, query); err != nil {
+		return nil, err
+	}
+
+	return c.d.rows(query, args), nil
+}
+
+type (
+	pinger             conn
+	legacy             conn
+	execerContext      conn
+	queryerContext     conn
+	connPrepareContext conn
+	connBeginTx        conn
+	sessionResetter    conn
+	namedValueChecker  conn
+	validator          conn
+)
+
+func (c *pinger) Ping(ctx context.Context) error {
+	return c.d.call(ctx, "Conn.Ping", "")
+}
+
+func (c *legacy) Exec(query string, args []driver.Value) (driver.Result, error) {
+	return (*conn)(c).exec(context.Background(), "Conn.Exec", query, namedValues(args))
+}
+
+func (c *execerContext) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return (*conn)(c).exec(ctx, "Conn.ExecContext", query, args)
+}
+
+func (c *legacy) Query(query string, args []driver.Value) (driver.Rows, error) {
+	return (*conn)(c).query(context.Background(), "Conn.Query", query, namedValues(args))
+}
+
+func (c *queryerContext) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return (*conn)(c).query(ctx, "Conn.QueryContext", query, args)
+}
+
+func (c *connPrepareContext) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return (*conn)(c).prepare(ctx, "Conn.PrepareContext", query)
+}
+
+func (c *connBeginTx) BeginTx(ctx context.Context, _ driver.TxOptions) (driver.Tx, error) {
+	return (*conn)(c).begin(ctx, "Conn.BeginTx")
+}
+
+func (c *sessionResetter) ResetSession(ctx context.Context) error {
+	return c.d.call(ctx, "Conn.ResetSession", "")
+}
+
+// CheckNamedValue returns driver.ErrSkip to use the default conversion,
+// if no error is injected.
+func (c *namedValueChecker) CheckNamedValue(*driver.NamedValue) error {
+	if err := c.d.record("Conn.CheckNamedValue", ""); err != nil {
+		return err
+	}
+
+	return driver.ErrSkip
+}
+
+// IsValid reports false, if the error is injected.
+func (c *validator) IsValid() bool {
+	return c.d.record("Conn.IsValid", "") == nil
+}
+
+// stmt is the prepared statement with the driver.Stmt methods.
+type stmt struct {
+	d     *Driver
+	query string
+}
+
+func (s *stmt) Close() error {
+	return s.d.call(context.Background(), "Stmt.Close", s.query)
+}
+
+func (s *stmt) NumInput() int { return -1 }
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.exec(context.Background(), "Stmt.Exec", namedValues(args))
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.queryRows(context.Background(), "Stmt.Query", namedValues(args))
+}
+
+func (s *stmt) exec(ctx context.Context, call string, args []driver.NamedValue) (driver.Result, error) {
+	if err := s.d.call(ctx, call, s.query); err != nil {
+		return nil, err
+	}
+
+	return s.d.result(s.query, args), nil
+}
+
+func (s *stmt) queryRows(ctx context.Context, call string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := s.d.call(ctx, call, s.query); err != nil {
+		return nil, err
+	}
+
+	return s.d.rows(s.query, args), nil
+}
+
+type (
+	stmtExecContext       stmt
+	stmtQueryContext      stmt
+	stmtNamedValueChecker stmt
+	columnConverter       stmt
+)
+
+func (s *stmtExecContext) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return (*stmt)(s).exec(ctx, "Stmt.ExecContext", args)
+}
+
+func (s *stmtQueryContext) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return (*stmt)(s).queryRows(ctx, "Stmt.QueryContext", args)
+}
+
+// CheckNamedValue returns driver.ErrSkip to use the default conversion,
+// if no error is injected.
+func (s *stmtNamedValueChecker) CheckNamedValue(*driver.NamedValue) error {
+	if err := s.d.record("Stmt.CheckNamedValue", s.query); err != nil {
+		return err
+	}
+
+	return driver.ErrSkip
+}
+
+// ColumnConverter returns the default parameter converter.
+func (s *columnConverter) ColumnConverter(int) driver.ValueConverter {
+	_ = s.d.record("Stmt.ColumnConverter", s.query) //nolint:errcheck // no error to return
+
+	return driver.DefaultParameterConverter
+}
+
+// tx is the transaction.
+type tx struct {
+	d *Driver
+}
+
+func (t *tx) Commit() error   { return t.d.call(context.Background(), "Tx.Commit", "") }
+func (t *tx) Rollback() error { return t.d.call(context.Background(), "Tx.Rollback", "") }
+
+// rows are the configured rows of the query.
+type rows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *rows) Columns() []string { return r.columns }
+func (r *rows) Close() error      { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+
+	return nil
+}
+
+func namedValues(args []driver.Value) []driver.NamedValue {
+	named := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		named[i] = driver.NamedValue{Ordinal: i + 1, Value: arg}
+	}
+
+	return named
+}
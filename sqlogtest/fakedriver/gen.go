@@ -0,0 +1,90 @@
+//go:build ignore
+
+// This program generates combos.go: the combinations of the optional
+// interfaces of the connections and statements.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+// optional is the optional interface with the type implementing it.
+type optional struct {
+	name string // Interface constant
+	typ  string // implementing type
+}
+
+var (
+	connOptionals = []optional{
+		{"Pinger", "pinger"},
+		{"Legacy", "legacy"},
+		{"ExecerContext", "execerContext"},
+		{"QueryerContext", "queryerContext"},
+		{"ConnPrepareContext", "connPrepareContext"},
+		{"ConnBeginTx", "connBeginTx"},
+		{"SessionResetter", "sessionResetter"},
+		{"NamedValueChecker", "namedValueChecker"},
+		{"Validator", "validator"},
+	}
+	stmtOptionals = []optional{
+		{"StmtExecContext", "stmtExecContext"},
+		{"StmtQueryContext", "stmtQueryContext"},
+		{"StmtNamedValueChecker", "stmtNamedValueChecker"},
+		{"ColumnConverter", "columnConverter"},
+	}
+)
+
+func main() {
+	var b bytes.Buffer
+
+	b.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\npackage fakedriver\n\n")
+	b.WriteString("import \"database/sql/driver\"\n\n")
+
+	combos(&b, "wrapConn", "c", "conn", "driver.Conn", "connInterfaces", connOptionals)
+	combos(&b, "wrapStmt", "s", "stmt", "driver.Stmt", "stmtInterfaces", stmtOptionals)
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("combos.go", src, 0o644); err != nil { //nolint:gosec // source file
+		log.Fatal(err)
+	}
+}
+
+// combos writes the function returning the value of the base type
+// implementing exactly the set of the optional interfaces.
+func combos(b *bytes.Buffer, fn, v, base, result, mask string, optionals []optional) {
+	fmt.Fprintf(b, "// %s returns %s implementing exactly the set of the optional interfaces.\n", fn, v)
+	fmt.Fprintf(b, "func %s(%s *%s, set Interface) %s {\n", fn, v, base, result)
+	fmt.Fprintf(b, "switch set & %s {\n", mask)
+
+	for combo := 0; combo < 1<<len(optionals); combo++ {
+		var names, types, values []string
+
+		for i, o := range optionals {
+			if combo&(1<<i) != 0 {
+				names = append(names, o.name)
+				types = append(types, "*"+o.typ)
+				values = append(values, fmt.Sprintf("(*%s)(%s)", o.typ, v))
+			}
+		}
+
+		if combo == 0 {
+			fmt.Fprintf(b, "case 0:\nreturn %s\n", v)
+			continue
+		}
+
+		fmt.Fprintf(b, "case %s:\nreturn struct{ *%s; %s }{%s, %s}\n",
+			strings.Join(names, " | "), base, strings.Join(types, "; "),
+			v, strings.Join(values, ", "))
+	}
+
+	fmt.Fprintf(b, "default:\npanic(\"unreachable\")\n}\n}\n\n")
+}
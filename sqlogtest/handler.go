@@ -0,0 +1,253 @@
+// Package sqlogtest provides the in-memory slog.Handler collecting SQL log
+// records and the helpers asserting in tests on the statements issued
+// by a code path:
+//
+//	h := sqlogtest.NewHandler()
+//	db, err := sqlog.Open("mysql", dsn, sqlog.WithHandler(h))
+//	...
+//	h.ExpectBegin(t)
+//	h.ExpectExec(t, `^insert into users`)
+//	h.ExpectCommit(t)
+//	h.AssertNoQueries(t)
+package sqlogtest
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Record is the collected SQL log record.
+type Record struct {
+	Time    time.Time
+	Level   slog.Level
+	Message string                // message with prefixes, e.g. "mysql:stmt:execContext"
+	Op      string                // operation without prefixes and context suffix, e.g. "exec"
+	Query   string                // query, also of the prepared statement
+	Args    []any                 // query arguments
+	ConnID  string                // connection identifier
+	StmtID  string                // statement identifier, if any
+	TxID    string                // transaction identifier, if any
+	Err     error                 // error of the operation, if any
+	Attrs   map[string]slog.Value // all resolved attributes, group keys are joined with dots
+}
+
+// Handler is the slog.Handler collecting SQL log records of all levels
+// in memory. It is safe for concurrent use.
+type Handler struct {
+	state *state
+	attrs []slog.Attr // attributes added with WithAttrs
+	group string      // group prefix of the attribute keys
+}
+
+// state is shared by the handler and its copies.
+type state struct {
+	mu      sync.Mutex
+	records []Record
+	queries map[string]string // queries of the prepared statements
+	next    int               // next expected statement
+}
+
+// NewHandler returns a new empty Handler.
+func NewHandler() *Handler {
+	return &Handler{state: &state{queries: make(map[string]string)}}
+}
+
+var _ slog.Handler = (*Handler)(nil)
+
+// Enabled reports true for all levels.
+func (h *Handler) Enabled(context.Context, slog.Level) bool { return true }
+
+// WithAttrs returns a new Handler whose attributes consist of
+// both the receiver's attributes and the arguments.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	h2 := *h
+	h2.attrs = make([]slog.Attr, 0, len(h.attrs)+len(attrs))
+	h2.attrs = append(h2.attrs, h.attrs...)
+
+	for _, a := range attrs {
+		if h.group != "" {
+			a.Key = h.group + a.Key
+		}
+
+		h2.attrs = append(h2.attrs, a)
+	}
+
+	return &h2
+}
+
+// WithGroup returns a new Handler with the given group appended to
+// the receiver's existing groups.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	h2 := *h
+	h2.group = h.group + name + "."
+
+	return &h2
+}
+
+// Handle collects the record.
+func (h *Handler) Handle(_ context.Context, r slog.Record) error {
+	rec := Record{
+		Time:    r.Time,
+		Level:   r.Level,
+		Message: r.Message,
+		Op:      operation(r.Message),
+		Attrs:   make(map[string]slog.Value, len(h.attrs)+r.NumAttrs()),
+	}
+
+	for _, a := range h.attrs {
+		addAttr(rec.Attrs, "", a)
+	}
+
+	r.Attrs(func(a slog.Attr) bool {
+		addAttr(rec.Attrs, h.group, a)
+		return true
+	})
+
+	rec.ConnID = stringAttr(rec.Attrs, "connID")
+	rec.StmtID = stringAttr(rec.Attrs, "stmtID")
+	rec.TxID = stringAttr(rec.Attrs, "txID")
+	rec.Query = stringAttr(rec.Attrs, "query")
+
+	if v, ok := rec.Attrs["args"]; ok {
+		rec.Args = anySlice(v.Any())
+	}
+
+	if v, ok := rec.Attrs["error"]; ok {
+		rec.Err, _ = v.Any().(error)
+	}
+
+	h.state.mu.Lock()
+	defer h.state.mu.Unlock()
+
+	if rec.StmtID != "" {
+		if rec.Query != "" {
+			h.state.queries[rec.StmtID] = rec.Query
+		} else {
+			rec.Query = h.state.queries[rec.StmtID]
+		}
+	}
+
+	h.state.records = append(h.state.records, rec)
+
+	return nil
+}
+
+// Records returns the collected records.
+func (h *Handler) Records() []Record {
+	h.state.mu.Lock()
+	defer h.state.mu.Unlock()
+
+	return append([]Record(nil), h.state.records...)
+}
+
+// Statements returns the collected records of the executed statements:
+// queries, executions and transactions.
+func (h *Handler) Statements() []Record {
+	h.state.mu.Lock()
+	defer h.state.mu.Unlock()
+
+	var statements []Record
+
+	for _, r := range h.state.records {
+		if r.isStatement() {
+			statements = append(statements, r)
+		}
+	}
+
+	return statements
+}
+
+// Reset removes the collected records and expectations.
+func (h *Handler) Reset() {
+	h.state.mu.Lock()
+	defer h.state.mu.Unlock()
+
+	h.state.records = nil
+	h.state.queries = make(map[string]string)
+	h.state.next = 0
+}
+
+// isStatement reports whether the record is of the executed statement.
+// The skipped driver calls, repeated with a fallback, and the failed
+// attempts of retried statements, logged with the retryIn attribute,
+// are ignored.
+func (r Record) isStatement() bool {
+	if _, ok := r.Attrs["retryIn"]; ok {
+		return false
+	}
+
+	switch r.Op {
+	case "exec", "query", "begin", "commit", "rollback":
+		return !errors.Is(r.Err, driver.ErrSkip)
+	default:
+		return false
+	}
+}
+
+// operation returns the operation of the message without the prefixes
+// and the "Context" suffix. The beginTx is the begin operation.
+func operation(msg string) string {
+	if i := strings.LastIndexByte(msg, ':'); i >= 0 {
+		msg = msg[i+1:]
+	}
+
+	if msg == "beginTx" {
+		return "begin"
+	}
+
+	return strings.TrimSuffix(msg, "Context")
+}
+
+func addAttr(attrs map[string]slog.Value, prefix string, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+
+	if a.Value.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			prefix += a.Key + "."
+		}
+
+		for _, ga := range a.Value.Group() {
+			addAttr(attrs, prefix, ga)
+		}
+
+		return
+	}
+
+	if a.Key != "" {
+		attrs[prefix+a.Key] = a.Value
+	}
+}
+
+func stringAttr(attrs map[string]slog.Value, key string) string {
+	if v, ok := attrs[key]; ok {
+		return v.String()
+	}
+
+	return ""
+}
+
+// anySlice returns the logged arguments as a slice.
+func anySlice(v any) []any {
+	switch v := v.(type) {
+	case []any:
+		return v
+	case []driver.Value:
+		args := make([]any, len(v))
+		for i, arg := range v {
+			args[i] = arg
+		}
+
+		return args
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,59 @@
+package sqlog
+
+import "database/sql/driver"
+
+// Unwrap returns the driver connection wrapped by the package, e.g. got
+// with sql.Conn.Raw, to reach the driver-specific API:
+//
+//	err := conn.Raw(func(driverConn any) error {
+//		pgxConn := sqlog.Unwrap(driverConn).(*stdlib.Conn)
+//		...
+//	})
+//
+// The not wrapped driver connection is returned as is, other values as nil.
+func Unwrap(driverConn any) driver.Conn {
+	if c, ok := driverConn.(interface{ Unwrap() driver.Conn }); ok {
+		return c.Unwrap()
+	}
+
+	c, _ := driverConn.(driver.Conn)
+
+	return c
+}
+
+// UnwrapStmt returns the driver statement wrapped by the package.
+// The not wrapped driver statement is returned as is, other values as nil.
+func UnwrapStmt(driverStmt any) driver.Stmt {
+	if s, ok := driverStmt.(interface{ Unwrap() driver.Stmt }); ok {
+		return s.Unwrap()
+	}
+
+	s, _ := driverStmt.(driver.Stmt)
+
+	return s
+}
+
+// UnwrapTx returns the driver transaction wrapped by the package.
+// The not wrapped driver transaction is returned as is, other values as nil.
+func UnwrapTx(driverTx any) driver.Tx {
+	if t, ok := driverTx.(interface{ Unwrap() driver.Tx }); ok {
+		return t.Unwrap()
+	}
+
+	t, _ := driverTx.(driver.Tx)
+
+	return t
+}
+
+// UnwrapRows returns the driver rows wrapped by the package.
+// The rows are wrapped only if needed, the not wrapped driver rows are
+// returned as is, other values as nil.
+func UnwrapRows(driverRows any) driver.Rows {
+	if r, ok := driverRows.(interface{ Unwrap() driver.Rows }); ok {
+		return r.Unwrap()
+	}
+
+	r, _ := driverRows.(driver.Rows)
+
+	return r
+}